@@ -4,17 +4,59 @@ import "github.com/goforj/docs/internal/docs"
 
 // AppCommands contains application-specific commands
 type AppCommands struct {
-	HelloWorldCmd       HelloWorldCmd        `cmd:"" name:"hello:world" help:"Hello world command" hidden:""`
-	DocsGenerateCommand docs.GenerateCommand `cmd:"" name:"docs:generate" help:"Generate documentation pages from repo READMEs"`
+	HelloWorldCmd             HelloWorldCmd              `cmd:"" name:"hello:world" help:"Hello world command" hidden:""`
+	DocsGenerateCommand       docs.GenerateCommand       `cmd:"" name:"docs:generate" help:"Generate documentation pages from repo READMEs"`
+	DocsAPICommand            docs.APICommand            `cmd:"" name:"docs:api" help:"Generate Go API reference pages from synced repo sources"`
+	DocsVerifySnippetsCommand docs.VerifySnippetsCommand `cmd:"" name:"docs:verify-snippets" help:"Type-check Go code blocks in synced repo READMEs"`
+	DocsChangelogCommand      docs.ChangelogCommand      `cmd:"" name:"docs:changelog" help:"Generate library changelog pages from synced repo tags and changelogs"`
+	DocsDriversCommand        docs.DriversCommand        `cmd:"" name:"docs:drivers" help:"Render the driver matrix from driver packages in synced library checkouts"`
+	DocsLinksCommand          docs.LinksCommand          `cmd:"" name:"docs:links" help:"Check internal links and heading anchors across the docs source"`
+	DocsLintCommand           docs.LintCommand           `cmd:"" name:"docs:lint" help:"Enforce ai/governance.json rules on planning docs and public pages"`
+	DocsTermsCommand          docs.TermsCommand          `cmd:"" name:"docs:terms" help:"Lint docs prose against the terminology rules from the ai/ style guides"`
+	DocsValidateCommand       docs.ValidateCommand       `cmd:"" name:"docs:validate" help:"Validate page frontmatter against the per-section schema"`
+	DocsReleaseCommand        docs.ReleaseCommand        `cmd:"" name:"docs:release" help:"Record a framework release in release.json and the changelog, or check they agree"`
+	DocsSnapshotCommand       docs.SnapshotCommand       `cmd:"" name:"docs:snapshot" help:"Freeze the active docs as /versions/<line>/ and register it in versions.json"`
+	DocsRedirectsCommand      docs.RedirectsCommand      `cmd:"" name:"docs:redirects" help:"List redirects for removed pages, or check their targets, fragments, chains and loops"`
+	DocsScenariosCommand      docs.ScenariosCommand      `cmd:"" name:"docs:scenarios" help:"Render docs/scenarios pages from executable scenario specs, or check them for drift"`
+	DocsExportCommand         docs.ExportCommand         `cmd:"" name:"docs:export" help:"Package the built site as a zip and the markdown sources as an EPUB for offline use"`
+	DocsHistoryCommand        docs.HistoryCommand        `cmd:"" name:"docs:history" help:"Write page last-updated dates, commits and authors from git history for the VitePress build"`
 }
 
 // NewAppCommands creates a new AppCommands instance with the given commands.
 func NewAppCommands(
 	helloWorldCmd *HelloWorldCmd, // Injected command
 	docsGenerateCommand *docs.GenerateCommand,
+	docsAPICommand *docs.APICommand,
+	docsVerifySnippetsCommand *docs.VerifySnippetsCommand,
+	docsChangelogCommand *docs.ChangelogCommand,
+	docsDriversCommand *docs.DriversCommand,
+	docsLinksCommand *docs.LinksCommand,
+	docsLintCommand *docs.LintCommand,
+	docsTermsCommand *docs.TermsCommand,
+	docsValidateCommand *docs.ValidateCommand,
+	docsReleaseCommand *docs.ReleaseCommand,
+	docsSnapshotCommand *docs.SnapshotCommand,
+	docsRedirectsCommand *docs.RedirectsCommand,
+	docsScenariosCommand *docs.ScenariosCommand,
+	docsExportCommand *docs.ExportCommand,
+	docsHistoryCommand *docs.HistoryCommand,
 ) *AppCommands {
 	return &AppCommands{
-		HelloWorldCmd:       *helloWorldCmd, // Assign the injected command
-		DocsGenerateCommand: *docsGenerateCommand,
+		HelloWorldCmd:             *helloWorldCmd, // Assign the injected command
+		DocsGenerateCommand:       *docsGenerateCommand,
+		DocsAPICommand:            *docsAPICommand,
+		DocsVerifySnippetsCommand: *docsVerifySnippetsCommand,
+		DocsChangelogCommand:      *docsChangelogCommand,
+		DocsDriversCommand:        *docsDriversCommand,
+		DocsLinksCommand:          *docsLinksCommand,
+		DocsLintCommand:           *docsLintCommand,
+		DocsTermsCommand:          *docsTermsCommand,
+		DocsValidateCommand:       *docsValidateCommand,
+		DocsReleaseCommand:        *docsReleaseCommand,
+		DocsSnapshotCommand:       *docsSnapshotCommand,
+		DocsRedirectsCommand:      *docsRedirectsCommand,
+		DocsScenariosCommand:      *docsScenariosCommand,
+		DocsExportCommand:         *docsExportCommand,
+		DocsHistoryCommand:        *docsHistoryCommand,
 	}
 }
@@ -9,4 +9,18 @@ import (
 var AppCommandSet = wire.NewSet(
 	NewHelloWorldCmd,
 	docs.NewDocsGenerateCommand,
+	docs.NewDocsAPICommand,
+	docs.NewDocsVerifySnippetsCommand,
+	docs.NewDocsChangelogCommand,
+	docs.NewDocsDriversCommand,
+	docs.NewDocsLinksCommand,
+	docs.NewDocsLintCommand,
+	docs.NewDocsTermsCommand,
+	docs.NewDocsValidateCommand,
+	docs.NewDocsReleaseCommand,
+	docs.NewDocsSnapshotCommand,
+	docs.NewDocsRedirectsCommand,
+	docs.NewDocsScenariosCommand,
+	docs.NewDocsExportCommand,
+	docs.NewDocsHistoryCommand,
 )
@@ -0,0 +1,90 @@
+package docs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/goforj/docs/internal/logger"
+)
+
+// APICommand renders Go API reference pages from the checkouts docs:generate synced.
+type APICommand struct {
+	Repo     string `name:"repo" help:"Only render the API page for a single repo slug (e.g. cache, queue, str)"`
+	Source   string `name:"source" type:"path" help:"Use a local repo checkout as the source (requires --repo)"`
+	Output   string `name:"output" type:"path" env:"DOCS_OUTPUT_DIR" help:"Docs root to write API pages into (defaults to ./docs or ../docs)"`
+	CacheDir string `name:"cache-dir" type:"path" env:"DOCS_CACHE_DIR" help:"Directory holding the checkouts synced by docs:generate"`
+	logger   *logger.AppLogger
+}
+
+// NewDocsAPICommand creates a new APICommand.
+func NewDocsAPICommand(logger *logger.AppLogger) *APICommand {
+	return &APICommand{
+		logger: logger,
+	}
+}
+
+// Run parses every selected checkout and writes its API reference page.
+func (c *APICommand) Run() error {
+	localSource, err := resolveLocalSource(c.Repo, c.Source)
+	if err != nil {
+		return err
+	}
+	repos, err := selectRepos(defaultRepos(), c.Repo)
+	if err != nil {
+		return err
+	}
+	docsRoot, err := resolveDocsRoot(c.Output)
+	if err != nil {
+		return err
+	}
+	cacheRoot, err := resolveCacheRoot(c.CacheDir)
+	if err != nil {
+		return err
+	}
+	c.logger.Info().Any("output", docsRoot).Any("cache", cacheRoot).Msg("Resolved API reference paths")
+
+	checkouts, err := resolveCheckouts(repos, cacheRoot, localSource, true)
+	if err != nil {
+		return err
+	}
+
+	for _, checkout := range checkouts {
+		repo := checkout.Repo
+		meta, err := readRepoMetadata(checkout.Dir, repoGitAuth(repo), checkout.Synced)
+		if err != nil {
+			return fmt.Errorf("read metadata for %s: %w", repo.Slug, err)
+		}
+		if meta.ModulePath == "" {
+			c.logger.Info().Any("repo", repo.Slug).Msg("Skipped API page (no go.mod)")
+			continue
+		}
+		packages, err := loadAPIPackages(checkout.Dir, meta.ModulePath)
+		if err != nil {
+			return fmt.Errorf("load API for %s: %w", repo.Slug, err)
+		}
+		if len(packages) == 0 {
+			c.logger.Info().Any("repo", repo.Slug).Msg("Skipped API page (no exported packages)")
+			continue
+		}
+
+		rendered := renderAPIReference(repo, meta, packages)
+		outputPath := filepath.Join(docsRoot, apiOutputPath(repo))
+		if generatedPageMatches(outputPath, rendered) {
+			c.logger.Info().Any("repo", repo.Slug).Msg("Skipped API page (source unchanged)")
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(outputPath), 0o755); err != nil {
+			return fmt.Errorf("ensure API output dir for %s: %w", repo.Slug, err)
+		}
+		if err := os.WriteFile(outputPath, []byte(rendered), 0o644); err != nil {
+			return fmt.Errorf("write API page for %s: %w", repo.Slug, err)
+		}
+		c.logger.Info().
+			Any("repo", repo.Slug).
+			Any("packages", len(packages)).
+			Any("output", outputPath).
+			Msg("Generated API page")
+	}
+	return nil
+}
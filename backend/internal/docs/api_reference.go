@@ -0,0 +1,396 @@
+package docs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/doc"
+	"go/doc/comment"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// apiPackage is one importable package discovered in a checkout.
+type apiPackage struct {
+	RelDir     string
+	ImportPath string
+	Doc        *doc.Package
+	Fset       *token.FileSet
+}
+
+// apiSkippedDirs never contain public API; examples are documented by the catalog instead of the reference.
+var apiSkippedDirs = map[string]struct{}{
+	"testdata": {},
+	"vendor":   {},
+	"internal": {},
+	"examples": {},
+	"example":  {},
+}
+
+// apiOutputPath nests the reference under the library page name so the library route and its API route share a prefix.
+func apiOutputPath(repo RepoConfig) string {
+	return filepath.Join(filepath.Dir(repo.OutputPath), libraryPageName(repo), "api.md")
+}
+
+// loadAPIPackages reads packages with the default build context so files excluded by build tags stay out of the reference.
+func loadAPIPackages(dir string, modulePath string) ([]apiPackage, error) {
+	var packages []apiPackage
+	err := filepath.WalkDir(dir, func(current string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() {
+			return nil
+		}
+		name := entry.Name()
+		if current != dir {
+			if _, skip := apiSkippedDirs[name]; skip || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+				return filepath.SkipDir
+			}
+		}
+
+		pkg, err := loadAPIPackage(dir, current, modulePath)
+		if err != nil {
+			return err
+		}
+		if pkg != nil {
+			packages = append(packages, *pkg)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(packages, func(i, j int) bool {
+		if packages[i].RelDir == "." || packages[j].RelDir == "." {
+			return packages[i].RelDir == "."
+		}
+		return packages[i].RelDir < packages[j].RelDir
+	})
+	return packages, nil
+}
+
+// loadAPIPackage returns nil for directories without an importable package.
+func loadAPIPackage(root string, dir string, modulePath string) (*apiPackage, error) {
+	buildPkg, err := build.Default.ImportDir(dir, 0)
+	if err != nil {
+		var noGo *build.NoGoError
+		if errors.As(err, &noGo) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("load package %s: %w", dir, err)
+	}
+	if buildPkg.Name == "main" {
+		return nil, nil
+	}
+
+	rel, err := filepath.Rel(root, dir)
+	if err != nil {
+		return nil, err
+	}
+	rel = filepath.ToSlash(rel)
+	importPath := modulePath
+	if rel != "." {
+		importPath = path.Join(modulePath, rel)
+	}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	names := append(append(append([]string{}, buildPkg.GoFiles...), buildPkg.TestGoFiles...), buildPkg.XTestGoFiles...)
+	for _, name := range names {
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", filepath.Join(rel, name), err)
+		}
+		files = append(files, file)
+	}
+
+	docPkg, err := doc.NewFromFiles(fset, files, importPath)
+	if err != nil {
+		return nil, fmt.Errorf("read docs for %s: %w", importPath, err)
+	}
+	if !hasExportedAPI(docPkg) {
+		return nil, nil
+	}
+	return &apiPackage{RelDir: rel, ImportPath: importPath, Doc: docPkg, Fset: fset}, nil
+}
+
+func hasExportedAPI(pkg *doc.Package) bool {
+	return len(pkg.Consts) > 0 || len(pkg.Vars) > 0 || len(pkg.Funcs) > 0 || len(pkg.Types) > 0
+}
+
+// apiAnchorPrefix leaves root declarations unprefixed so README links such as #Cache.Remember keep resolving.
+func apiAnchorPrefix(relDir string) string {
+	if relDir == "." || relDir == "" {
+		return ""
+	}
+	return strings.ReplaceAll(relDir, "/", "-") + "."
+}
+
+// renderAPIReference produces a deterministic page so unchanged sources never rewrite the output.
+func renderAPIReference(repo RepoConfig, meta RepoMetadata, packages []apiPackage) string {
+	ref := sourceRef(repo, meta)
+	links := sourceLinks(repo)
+
+	anchors := map[string]string{}
+	names := map[string]string{}
+	for _, pkg := range packages {
+		anchors[pkg.ImportPath] = apiAnchorPrefix(pkg.RelDir)
+		if _, duplicate := names[pkg.Doc.Name]; duplicate {
+			names[pkg.Doc.Name] = ""
+			continue
+		}
+		names[pkg.Doc.Name] = pkg.ImportPath
+	}
+
+	title := libraryTitle(repo)
+	modulePath := meta.ModulePath
+	if modulePath == "" && len(packages) > 0 {
+		modulePath = packages[0].ImportPath
+	}
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "---\ntitle: %s\n", strconv.Quote(title+" API"))
+	fmt.Fprintf(&out, "description: %s\n", strconv.Quote(fmt.Sprintf("Exported Go API for %s, generated from source.", modulePath)))
+	fmt.Fprintf(&out, "repoSlug: %s\nrepoUrl: %s\n", repo.Slug, links.web())
+	if meta.CommitSHA != "" {
+		fmt.Fprintf(&out, "sourceCommit: %s\n", strconv.Quote(meta.CommitSHA))
+	}
+	out.WriteString("---\n\n")
+	fmt.Fprintf(&out, "# %s API\n\n", title)
+	fmt.Fprintf(&out, "Generated from [`%s`](%s) at `%s`. ", modulePath, links.tree(ref, ""), shortFingerprint(ref))
+	out.WriteString("Change this page by editing the doc comments in the source repository.\n")
+
+	for _, pkg := range packages {
+		renderer := apiRenderer{
+			out:     &out,
+			pkg:     pkg,
+			prefix:  anchors[pkg.ImportPath],
+			anchors: anchors,
+			names:   names,
+			source:  links.blob(ref, ""),
+		}
+		renderer.writePackage()
+	}
+	return strings.TrimRight(out.String(), "\n") + "\n"
+}
+
+// apiRenderer writes one package section of an API reference page.
+type apiRenderer struct {
+	out     *strings.Builder
+	pkg     apiPackage
+	prefix  string
+	anchors map[string]string
+	names   map[string]string
+	source  string
+}
+
+func (r *apiRenderer) writePackage() {
+	docPkg := r.pkg.Doc
+	fmt.Fprintf(r.out, "\n## Package %s {#%s}\n\n", docPkg.Name, r.packageAnchor())
+	fmt.Fprintf(r.out, "```go\nimport %q\n```\n", r.pkg.ImportPath)
+	r.writeDoc(docPkg.Doc)
+	r.writeExamples(docPkg.Examples)
+
+	r.writeValues("Constants", "constants", docPkg.Consts)
+	r.writeValues("Variables", "variables", docPkg.Vars)
+	for _, fn := range docPkg.Funcs {
+		r.writeFunc("###", fn.Name, fn)
+	}
+	for _, typ := range docPkg.Types {
+		r.writeType(typ)
+	}
+}
+
+func (r *apiRenderer) packageAnchor() string {
+	if r.prefix == "" {
+		return "pkg-" + r.pkg.Doc.Name
+	}
+	return "pkg-" + strings.TrimSuffix(r.prefix, ".")
+}
+
+func (r *apiRenderer) writeValues(title string, anchor string, values []*doc.Value) {
+	if len(values) == 0 {
+		return
+	}
+	fmt.Fprintf(r.out, "\n### %s {#%s%s}\n", title, r.prefix, anchor)
+	for _, value := range values {
+		r.writeValue(value)
+	}
+}
+
+func (r *apiRenderer) writeValue(value *doc.Value) {
+	decl := *value.Decl
+	decl.Doc = nil
+	r.writeSignature(&decl)
+	r.writeDoc(value.Doc)
+	r.writeSource(value.Decl.Pos())
+}
+
+func (r *apiRenderer) writeType(typ *doc.Type) {
+	fmt.Fprintf(r.out, "\n### %s {#%s%s}\n", typ.Name, r.prefix, typ.Name)
+	decl := *typ.Decl
+	decl.Doc = nil
+	r.writeSignature(&decl)
+	r.writeDoc(typ.Doc)
+	r.writeSource(typ.Decl.Pos())
+	r.writeExamples(typ.Examples)
+
+	for _, value := range typ.Consts {
+		r.writeValue(value)
+	}
+	for _, value := range typ.Vars {
+		r.writeValue(value)
+	}
+	for _, fn := range typ.Funcs {
+		r.writeFunc("####", fn.Name, fn)
+	}
+	for _, method := range typ.Methods {
+		r.writeFunc("####", typ.Name+"."+method.Name, method)
+	}
+}
+
+func (r *apiRenderer) writeFunc(level string, name string, fn *doc.Func) {
+	fmt.Fprintf(r.out, "\n%s %s {#%s%s}\n", level, name, r.prefix, name)
+	decl := *fn.Decl
+	decl.Doc = nil
+	decl.Body = nil
+	r.writeSignature(&decl)
+	r.writeDoc(fn.Doc)
+	r.writeSource(fn.Decl.Pos())
+	r.writeExamples(fn.Examples)
+}
+
+func (r *apiRenderer) writeSignature(node ast.Node) {
+	fmt.Fprintf(r.out, "\n```go\n%s\n```\n", r.printNode(node))
+}
+
+// writeDoc renders doc links to page anchors so cross references between packages in the same checkout stay on the page,
+// including sibling packages the documented file does not import.
+func (r *apiRenderer) writeDoc(text string) {
+	if strings.TrimSpace(text) == "" {
+		return
+	}
+	printer := r.pkg.Doc.Printer()
+	printer.HeadingLevel = 5
+	printer.HeadingID = func(heading *comment.Heading) string {
+		return r.prefix + "hdr-" + defaultAnchor(commentPlainText(heading.Text))
+	}
+	printer.DocLinkURL = func(link *comment.DocLink) string {
+		name := link.Name
+		if link.Recv != "" {
+			name = link.Recv + "." + link.Name
+		}
+		importPath := link.ImportPath
+		if importPath == "" {
+			importPath = r.pkg.ImportPath
+		}
+		if prefix, ok := r.anchors[importPath]; ok {
+			if name == "" {
+				return "#pkg-" + strings.TrimSuffix(prefix, ".")
+			}
+			return "#" + prefix + name
+		}
+		return link.DefaultURL("https://pkg.go.dev")
+	}
+	parser := r.pkg.Doc.Parser()
+	lookup := parser.LookupPackage
+	parser.LookupPackage = func(name string) (string, bool) {
+		if importPath, ok := lookup(name); ok {
+			return importPath, true
+		}
+		importPath := r.names[name]
+		return importPath, importPath != ""
+	}
+	fmt.Fprintf(r.out, "\n%s", printer.Markdown(parser.Parse(text)))
+}
+
+func (r *apiRenderer) writeSource(pos token.Pos) {
+	position := r.pkg.Fset.Position(pos)
+	if !position.IsValid() {
+		return
+	}
+	file := path.Join(r.pkg.RelDir, filepath.Base(position.Filename))
+	fmt.Fprintf(r.out, "\n[Source](%s%s#L%d)\n", r.source, file, position.Line)
+}
+
+func (r *apiRenderer) writeExamples(examples []*doc.Example) {
+	for _, example := range examples {
+		label := "Example"
+		if example.Suffix != "" {
+			label += " (" + example.Suffix + ")"
+		}
+		fmt.Fprintf(r.out, "\n**%s**\n", label)
+		if strings.TrimSpace(example.Doc) != "" {
+			r.writeDoc(example.Doc)
+		}
+		code := r.printNode(&printer.CommentedNode{Node: example.Code, Comments: exampleComments(example.Comments)})
+		fmt.Fprintf(r.out, "\n```go\n%s\n```\n", exampleBody(code))
+		if example.Output != "" {
+			fmt.Fprintf(r.out, "\nOutput:\n\n```text\n%s\n```\n", strings.TrimRight(example.Output, "\n"))
+		}
+	}
+}
+
+func (r *apiRenderer) printNode(node any) string {
+	var buf bytes.Buffer
+	config := printer.Config{Mode: printer.UseSpaces | printer.TabIndent, Tabwidth: 8}
+	if err := config.Fprint(&buf, r.pkg.Fset, node); err != nil {
+		return ""
+	}
+	return buf.String()
+}
+
+// exampleBody strips the function braces go/doc keeps around example code and removes the indentation they imply.
+func exampleBody(code string) string {
+	trimmed := strings.TrimSpace(code)
+	if !strings.HasPrefix(trimmed, "{") || !strings.HasSuffix(trimmed, "}") {
+		return trimmed
+	}
+	trimmed = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(trimmed, "{"), "}"))
+	lines := strings.Split(trimmed, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimPrefix(line, "\t")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// exampleComments drops the output directive because the expected output is rendered as its own block.
+func exampleComments(groups []*ast.CommentGroup) []*ast.CommentGroup {
+	kept := make([]*ast.CommentGroup, 0, len(groups))
+	for _, group := range groups {
+		text := strings.TrimSpace(group.Text())
+		if strings.HasPrefix(text, "Output:") || strings.HasPrefix(text, "Unordered output:") {
+			continue
+		}
+		kept = append(kept, group)
+	}
+	return kept
+}
+
+func commentPlainText(texts []comment.Text) string {
+	var out strings.Builder
+	for _, text := range texts {
+		switch text := text.(type) {
+		case comment.Plain:
+			out.WriteString(string(text))
+		case comment.Italic:
+			out.WriteString(string(text))
+		case *comment.Link:
+			out.WriteString(commentPlainText(text.Text))
+		case *comment.DocLink:
+			out.WriteString(commentPlainText(text.Text))
+		}
+	}
+	return out.String()
+}
@@ -0,0 +1,116 @@
+package docs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeTestFiles materializes a small checkout so parser-backed generators run against real files.
+func writeTestFiles(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("create %s: %v", name, err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+}
+
+// TestRenderAPIReference verifies stable anchors, signatures, doc links, source links and examples.
+func TestRenderAPIReference(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	writeTestFiles(t, root, map[string]string{
+		"go.mod": "module github.com/goforj/cache\n\ngo 1.24\n",
+		"cache.go": `// Package cache stores values.
+package cache
+
+// DefaultTTL is used when no TTL is given.
+const DefaultTTL = 60
+
+// Cache stores values. See [Cache.Remember].
+type Cache struct {
+	hits int
+}
+
+// New creates a cache backed by [redis.Store].
+func New() *Cache { return &Cache{} }
+
+// Remember returns a cached value or computes it.
+func (c *Cache) Remember(key string, fn func() string) string { return fn() }
+
+func unexported() {}
+`,
+		"cache_test.go": `package cache
+
+import "fmt"
+
+func ExampleCache_Remember() {
+	c := New()
+	fmt.Println(c.Remember("k", func() string { return "v" }))
+	// Output: v
+}
+`,
+		"driver/redis/store.go":     "package redis\n\n// Store is a Redis-backed store.\ntype Store struct{}\n",
+		"internal/hidden/hidden.go": "package hidden\n\nfunc Hidden() {}\n",
+		"examples/basic/main.go":    "package main\n\nfunc main() {}\n",
+	})
+
+	packages, err := loadAPIPackages(root, "github.com/goforj/cache")
+	if err != nil {
+		t.Fatalf("loadAPIPackages() error = %v", err)
+	}
+	if len(packages) != 2 || packages[0].RelDir != "." || packages[1].RelDir != "driver/redis" {
+		t.Fatalf("loadAPIPackages() = %#v, want root and driver/redis", packages)
+	}
+
+	repo := RepoConfig{Slug: "cache", Title: "Cache", CloneURL: "https://github.com/goforj/cache.git", Branch: "main"}
+	meta := RepoMetadata{ModulePath: "github.com/goforj/cache", CommitSHA: "5ab5e9cbabd399576026d337f50b9df62c00aa8e"}
+	got := renderAPIReference(repo, meta, packages)
+	for _, want := range []string{
+		`title: "Cache API"`,
+		`sourceCommit: "5ab5e9cbabd399576026d337f50b9df62c00aa8e"`,
+		"## Package cache {#pkg-cache}",
+		"### Constants {#constants}",
+		"const DefaultTTL = 60",
+		"### Cache {#Cache}",
+		"// contains filtered or unexported fields",
+		"See [Cache.Remember](#Cache.Remember).",
+		"#### New {#New}",
+		"New creates a cache backed by [redis.Store](#driver-redis.Store).",
+		"#### Cache.Remember {#Cache.Remember}",
+		"func (c *Cache) Remember(key string, fn func() string) string",
+		"[Source](https://github.com/goforj/cache/blob/5ab5e9cbabd399576026d337f50b9df62c00aa8e/cache.go#L16)",
+		"**Example**",
+		"c := New()\nfmt.Println(",
+		"Output:\n\n```text\nv\n```",
+		"## Package redis {#pkg-driver-redis}",
+		"### Store {#driver-redis.Store}",
+		"[Source](https://github.com/goforj/cache/blob/5ab5e9cbabd399576026d337f50b9df62c00aa8e/driver/redis/store.go#L4)",
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("renderAPIReference() missing %q in:\n%s", want, got)
+		}
+	}
+	for _, unwanted := range []string{"func unexported", "Hidden", "func main"} {
+		if strings.Contains(got, unwanted) {
+			t.Fatalf("renderAPIReference() rendered %q:\n%s", unwanted, got)
+		}
+	}
+}
+
+// TestAPIOutputPath verifies the reference lives beside the library page it documents.
+func TestAPIOutputPath(t *testing.T) {
+	t.Parallel()
+
+	repo := RepoConfig{Slug: "str", OutputPath: filepath.Join("libraries", "strings.md")}
+	if got, want := apiOutputPath(repo), filepath.Join("libraries", "strings", "api.md"); got != want {
+		t.Fatalf("apiOutputPath() = %q, want %q", got, want)
+	}
+}
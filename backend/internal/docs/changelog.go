@@ -0,0 +1,351 @@
+package docs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/semver"
+)
+
+var changelogFileNames = []string{"CHANGELOG.md", "CHANGELOG", "changelog.md", "CHANGES.md", "HISTORY.md"}
+var changelogVersionHeadingRegex = regexp.MustCompile(`^(#{1,3})\s+\[?(v?\d+\.\d+\.\d+[^\]\s)]*)\]?(.*)$`)
+var changelogDateRegex = regexp.MustCompile(`\d{4}-\d{2}-\d{2}`)
+var releaseAnchorRegex = regexp.MustCompile(`[^a-z0-9]+`)
+
+// maxReleaseCommits keeps tag-derived sections readable for releases cut after long gaps.
+const maxReleaseCommits = 30
+
+// changelogRelease is one released version of a library, from its changelog file or from git tags.
+type changelogRelease struct {
+	Version string
+	Date    string
+	Body    string
+	Commits []changelogCommit
+}
+
+type changelogCommit struct {
+	SHA     string
+	Subject string
+}
+
+// libraryChangelog is every release of one library, newest first.
+type libraryChangelog struct {
+	Repo     RepoConfig
+	Source   string
+	Releases []changelogRelease
+}
+
+type tagRef struct {
+	Name       string
+	Date       string
+	Annotation string
+}
+
+// changelogOutputPath mirrors the library page name under /versions/libraries/ so the two routes are easy to pair.
+func changelogOutputPath(repo RepoConfig) string {
+	return filepath.Join("versions", "libraries", filepath.Base(repo.OutputPath))
+}
+
+// readLibraryChangelog prefers a maintained changelog file and falls back to tag annotations and commit subjects.
+func readLibraryChangelog(repo RepoConfig, dir string) (libraryChangelog, error) {
+	tags, err := readTagRefs(dir)
+	if err != nil {
+		return libraryChangelog{}, err
+	}
+	tagDates := map[string]string{}
+	for _, tag := range tags {
+		tagDates[tag.Name] = tag.Date
+	}
+
+	for _, name := range changelogFileNames {
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return libraryChangelog{}, fmt.Errorf("read %s: %w", name, err)
+		}
+		releases := parseChangelogFile(string(content))
+		for i := range releases {
+			if releases[i].Date == "" {
+				releases[i].Date = tagDates[releases[i].Version]
+			}
+		}
+		return libraryChangelog{Repo: repo, Source: name, Releases: releases}, nil
+	}
+
+	releases, err := releasesFromTags(dir, tags)
+	if err != nil {
+		return libraryChangelog{}, err
+	}
+	return libraryChangelog{Repo: repo, Source: "tags", Releases: releases}, nil
+}
+
+// parseChangelogFile accepts Keep a Changelog and plain version headings; unreleased notes are left to the library repo.
+func parseChangelogFile(content string) []changelogRelease {
+	var releases []changelogRelease
+	var current *changelogRelease
+	var body []string
+	level := 0
+	flush := func() {
+		if current != nil {
+			current.Body = strings.TrimSpace(strings.Join(body, "\n"))
+			releases = append(releases, *current)
+		}
+		current = nil
+		body = nil
+	}
+
+	inCode := false
+	for _, line := range strings.Split(content, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCode = !inCode
+		}
+		if !inCode {
+			if matches := changelogVersionHeadingRegex.FindStringSubmatch(line); matches != nil && (level == 0 || len(matches[1]) <= level) {
+				flush()
+				level = len(matches[1])
+				version := matches[2]
+				if !strings.HasPrefix(version, "v") {
+					version = "v" + version
+				}
+				current = &changelogRelease{Version: version, Date: changelogDateRegex.FindString(matches[3])}
+				continue
+			}
+			if level != 0 && strings.HasPrefix(line, strings.Repeat("#", level)+" ") {
+				flush()
+				continue
+			}
+		}
+		if current != nil {
+			body = append(body, line)
+		}
+	}
+	flush()
+	return releases
+}
+
+// readTagRefs lists root-module release tags; driver module tags such as driver/redis/v1.0.0 are not library releases.
+func readTagRefs(dir string) ([]tagRef, error) {
+	if !isGitRepo(dir) {
+		return nil, nil
+	}
+	output, err := gitOutput(dir, "for-each-ref", "refs/tags",
+		"--format=%(refname:short)%00%(creatordate:short)%00%(objecttype)%00%(contents)%1e")
+	if err != nil {
+		return nil, fmt.Errorf("list tags: %w", err)
+	}
+
+	var tags []tagRef
+	for _, record := range strings.Split(output, "\x1e") {
+		fields := strings.SplitN(strings.TrimLeft(record, "\n"), "\x00", 4)
+		if len(fields) != 4 || !semver.IsValid(fields[0]) {
+			continue
+		}
+		tag := tagRef{Name: fields[0], Date: fields[1]}
+		if fields[2] == "tag" {
+			tag.Annotation = strings.TrimSpace(stripSignature(fields[3]))
+		}
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return semver.Compare(tags[i].Name, tags[j].Name) > 0
+	})
+	return tags, nil
+}
+
+// stripSignature removes PGP or SSH signatures that git appends to signed tag contents.
+func stripSignature(contents string) string {
+	if index := strings.Index(contents, "-----BEGIN "); index != -1 {
+		return contents[:index]
+	}
+	return contents
+}
+
+// releasesFromTags describes each tag by its annotation and the commit subjects since the previous tag.
+func releasesFromTags(dir string, tags []tagRef) ([]changelogRelease, error) {
+	releases := make([]changelogRelease, 0, len(tags))
+	for i, tag := range tags {
+		rangeSpec := tag.Name
+		if i+1 < len(tags) {
+			rangeSpec = tags[i+1].Name + ".." + tag.Name
+		}
+		output, err := gitOutput(dir, "log", "--no-merges", fmt.Sprintf("--max-count=%d", maxReleaseCommits), "--format=%H%x00%s", rangeSpec)
+		if err != nil {
+			return nil, fmt.Errorf("read commits for %s: %w", tag.Name, err)
+		}
+		var commits []changelogCommit
+		for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+			sha, subject, ok := strings.Cut(line, "\x00")
+			if ok {
+				commits = append(commits, changelogCommit{SHA: sha, Subject: subject})
+			}
+		}
+		releases = append(releases, changelogRelease{
+			Version: tag.Name,
+			Date:    tag.Date,
+			Body:    tag.Annotation,
+			Commits: commits,
+		})
+	}
+	return releases, nil
+}
+
+// releaseAnchor matches the VitePress slug for version headings so existing /versions links keep working.
+func releaseAnchor(version string) string {
+	return strings.Trim(releaseAnchorRegex.ReplaceAllString(strings.ToLower(version), "-"), "-")
+}
+
+func tagURL(repo RepoConfig, tag string) string {
+	return sourceLinks(repo).tag(tag)
+}
+
+func commitURL(repo RepoConfig, sha string) string {
+	return sourceLinks(repo).commit(sha)
+}
+
+// renderLibraryChangelog demotes release notes under per-version headings and rewrites repository-relative links.
+func renderLibraryChangelog(changelog libraryChangelog) string {
+	repo := changelog.Repo
+	title := libraryTitle(repo)
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "---\ntitle: %s\ndescription: %s\n---\n\n", strconv.Quote(title+" Changelog"), strconv.Quote(fmt.Sprintf("Release history for the %s library, generated from its repository.", title)))
+	fmt.Fprintf(&out, "# %s Changelog\n\n", title)
+	source := "its `" + changelog.Source + "`"
+	if changelog.Source == "tags" {
+		source = "its release tags and commit history"
+	}
+	fmt.Fprintf(&out, "Generated from %s. See the [%s library page](/%s) for usage.\n", source, title, libraryPageName(repo))
+	if len(changelog.Releases) == 0 {
+		out.WriteString("\nNo tagged releases yet.\n")
+	}
+
+	for _, release := range changelog.Releases {
+		fmt.Fprintf(&out, "\n## %s {#%s}\n\n", release.Version, releaseAnchor(release.Version))
+		meta := fmt.Sprintf("[Tag](%s)", tagURL(repo, release.Version))
+		if release.Date != "" {
+			meta = "Released " + formatReleaseDate(release.Date) + " · " + meta
+		}
+		out.WriteString(meta + "\n")
+		if release.Body != "" {
+			body := rewriteMarkdownLinks(demoteHeadings(release.Body, 3), repo, "")
+			fmt.Fprintf(&out, "\n%s\n", body)
+		}
+		if len(release.Commits) > 0 {
+			out.WriteString("\n")
+			for _, commit := range release.Commits {
+				fmt.Fprintf(&out, "- %s ([`%s`](%s))\n", commit.Subject, shortSHA(commit.SHA), commitURL(repo, commit.SHA))
+			}
+		}
+	}
+	return out.String()
+}
+
+// renderStackChangelog merges every library release into one timeline grouped by release day, newest first.
+func renderStackChangelog(changelogs []libraryChangelog) string {
+	type entry struct {
+		repo    RepoConfig
+		release changelogRelease
+	}
+	byDate := map[string][]entry{}
+	for _, changelog := range changelogs {
+		for _, release := range changelog.Releases {
+			if release.Date == "" {
+				continue
+			}
+			byDate[release.Date] = append(byDate[release.Date], entry{repo: changelog.Repo, release: release})
+		}
+	}
+	dates := make([]string, 0, len(byDate))
+	for date := range byDate {
+		dates = append(dates, date)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(dates)))
+
+	var out strings.Builder
+	out.WriteString("---\ntitle: Library Changes\ndescription: \"What changed across the GoForj library stack, grouped by release date.\"\n---\n\n")
+	out.WriteString("# What Changed Across the Stack\n\n")
+	out.WriteString("Every first-party library release, newest first. Each entry links to the library changelog, the release tag, and the commits it contains.\n")
+	for _, date := range dates {
+		entries := byDate[date]
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].repo.Slug < entries[j].repo.Slug
+		})
+		fmt.Fprintf(&out, "\n## %s {#%s}\n\n", formatReleaseDate(date), date)
+		for _, item := range entries {
+			page := "/versions/libraries/" + libraryPageName(item.repo)
+			fmt.Fprintf(&out, "- [%s %s](%s#%s) · [tag](%s)", item.repo.Slug, item.release.Version, page, releaseAnchor(item.release.Version), tagURL(item.repo, item.release.Version))
+			if summary := releaseSummary(item.repo, item.release); summary != "" {
+				fmt.Fprintf(&out, " — %s", summary)
+			}
+			out.WriteString("\n")
+		}
+	}
+	return out.String()
+}
+
+// releaseSummary picks the first prose line of the notes, or the newest commit subject for tag-only releases.
+func releaseSummary(repo RepoConfig, release changelogRelease) string {
+	for _, line := range strings.Split(release.Body, "\n") {
+		trimmed := strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "-*"))
+		if trimmed != "" && !strings.HasPrefix(trimmed, "#") {
+			return trimmed
+		}
+	}
+	if len(release.Commits) > 0 {
+		commit := release.Commits[0]
+		return fmt.Sprintf("%s ([`%s`](%s))", commit.Subject, shortSHA(commit.SHA), commitURL(repo, commit.SHA))
+	}
+	return ""
+}
+
+// demoteHeadings shifts headings so the shallowest one lands at minLevel, keeping release notes under their version heading.
+func demoteHeadings(content string, minLevel int) string {
+	lines := strings.Split(content, "\n")
+	shallowest := 0
+	forEachProseLine(lines, func(_ int, line string) {
+		if matches := markdownHeadingRegex.FindStringSubmatch(line); matches != nil {
+			if shallowest == 0 || len(matches[1]) < shallowest {
+				shallowest = len(matches[1])
+			}
+		}
+	})
+	if shallowest == 0 || shallowest >= minLevel {
+		return content
+	}
+	shift := minLevel - shallowest
+	forEachProseLine(lines, func(index int, line string) {
+		if matches := markdownHeadingRegex.FindStringSubmatch(line); matches != nil {
+			level := len(matches[1]) + shift
+			if level > 6 {
+				level = 6
+			}
+			lines[index] = strings.Repeat("#", level) + " " + matches[2]
+		}
+	})
+	return strings.Join(lines, "\n")
+}
+
+// formatReleaseDate matches the "Released August 12, 2026." style of the hand-written changelog.
+func formatReleaseDate(date string) string {
+	parsed, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return date
+	}
+	return parsed.Format("January 2, 2006")
+}
+
+func shortSHA(sha string) string {
+	if len(sha) <= 7 {
+		return sha
+	}
+	return sha[:7]
+}
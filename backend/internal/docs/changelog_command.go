@@ -0,0 +1,88 @@
+package docs
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/goforj/docs/internal/logger"
+)
+
+// ChangelogCommand renders library changelog pages from the checkouts docs:generate synced.
+type ChangelogCommand struct {
+	Repo     string `name:"repo" help:"Only render the changelog for a single repo slug (e.g. cache, queue, str)"`
+	Source   string `name:"source" type:"path" help:"Use a local repo checkout as the source (requires --repo)"`
+	Output   string `name:"output" type:"path" env:"DOCS_OUTPUT_DIR" help:"Docs root to write changelog pages into (defaults to ./docs or ../docs)"`
+	CacheDir string `name:"cache-dir" type:"path" env:"DOCS_CACHE_DIR" help:"Directory holding the checkouts synced by docs:generate"`
+	Offline  bool   `name:"offline" help:"Use only the tags and history already present in each checkout"`
+	logger   *logger.AppLogger
+}
+
+// NewDocsChangelogCommand creates a new ChangelogCommand.
+func NewDocsChangelogCommand(logger *logger.AppLogger) *ChangelogCommand {
+	return &ChangelogCommand{
+		logger: logger,
+	}
+}
+
+// Run writes one changelog page per library plus the combined stack timeline.
+func (c *ChangelogCommand) Run() error {
+	localSource, err := resolveLocalSource(c.Repo, c.Source)
+	if err != nil {
+		return err
+	}
+	repos, err := selectRepos(defaultRepos(), c.Repo)
+	if err != nil {
+		return err
+	}
+	docsRoot, err := resolveDocsRoot(c.Output)
+	if err != nil {
+		return err
+	}
+	cacheRoot, err := resolveCacheRoot(c.CacheDir)
+	if err != nil {
+		return err
+	}
+	c.logger.Info().Any("output", docsRoot).Any("cache", cacheRoot).Msg("Resolved changelog paths")
+
+	checkouts, err := resolveCheckouts(repos, cacheRoot, localSource, false)
+	if err != nil {
+		return err
+	}
+
+	changelogs := make([]libraryChangelog, 0, len(checkouts))
+	for _, checkout := range checkouts {
+		repo := checkout.Repo
+		if !c.Offline && localSource == "" && isGitRepo(checkout.Dir) {
+			if err := ensureFullHistory(checkout.Dir, repoGitAuth(repo)); err != nil {
+				c.logger.Warn().Any("repo", repo.Slug).Err(err).Msg("Could not fetch tag history; using local history")
+			}
+		}
+		changelog, err := readLibraryChangelog(repo, checkout.Dir)
+		if err != nil {
+			return fmt.Errorf("read changelog for %s: %w", repo.Slug, err)
+		}
+		changelogs = append(changelogs, changelog)
+
+		outputPath := filepath.Join(docsRoot, changelogOutputPath(repo))
+		if err := writeGeneratedPage(outputPath, renderLibraryChangelog(changelog)); err != nil {
+			return fmt.Errorf("write changelog for %s: %w", repo.Slug, err)
+		}
+		c.logger.Info().
+			Any("repo", repo.Slug).
+			Any("source", changelog.Source).
+			Any("releases", len(changelog.Releases)).
+			Any("output", outputPath).
+			Msg("Generated changelog page")
+	}
+
+	// A filtered run would drop every other library from the combined timeline.
+	if c.Repo != "" {
+		return nil
+	}
+	outputPath := filepath.Join(docsRoot, "versions", "libraries", "index.md")
+	if err := writeGeneratedPage(outputPath, renderStackChangelog(changelogs)); err != nil {
+		return fmt.Errorf("write stack changelog: %w", err)
+	}
+	c.logger.Info().Any("output", outputPath).Msg("Generated stack changelog")
+	return nil
+}
@@ -0,0 +1,123 @@
+package docs
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// runTestGit runs git with a fixed identity and dates so tag and commit fixtures are deterministic.
+func runTestGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-C", dir, "-c", "user.name=Docs", "-c", "user.email=docs@example.com", "-c", "commit.gpgsign=false", "-c", "tag.gpgsign=false"}, args...)...)
+	cmd.Env = append(cmd.Environ(), "GIT_AUTHOR_DATE=2026-08-01T10:00:00Z", "GIT_COMMITTER_DATE=2026-08-01T10:00:00Z")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, output)
+	}
+}
+
+// TestParseChangelogFile verifies Keep a Changelog headings, dates and unreleased sections.
+func TestParseChangelogFile(t *testing.T) {
+	t.Parallel()
+
+	content := strings.Join([]string{
+		"# Changelog",
+		"",
+		"## [Unreleased]",
+		"- Pending work.",
+		"",
+		"## [1.2.0] - 2026-08-12",
+		"### Added",
+		"- `Remember` helper.",
+		"",
+		"## v1.1.0",
+		"- Initial driver set.",
+	}, "\n")
+
+	got := parseChangelogFile(content)
+	if len(got) != 2 {
+		t.Fatalf("parseChangelogFile() returned %d releases, want 2: %#v", len(got), got)
+	}
+	if got[0].Version != "v1.2.0" || got[0].Date != "2026-08-12" || got[0].Body != "### Added\n- `Remember` helper." {
+		t.Fatalf("parseChangelogFile()[0] = %#v", got[0])
+	}
+	if got[1].Version != "v1.1.0" || got[1].Date != "" || got[1].Body != "- Initial driver set." {
+		t.Fatalf("parseChangelogFile()[1] = %#v", got[1])
+	}
+}
+
+// TestReadLibraryChangelogFromTags verifies tag annotations, dates and commit ranges when no changelog file exists.
+func TestReadLibraryChangelogFromTags(t *testing.T) {
+	dir := t.TempDir()
+	runTestGit(t, dir, "init", "--quiet")
+	runTestGit(t, dir, "commit", "--quiet", "--allow-empty", "-m", "Initial import")
+	runTestGit(t, dir, "tag", "v0.1.0")
+	runTestGit(t, dir, "commit", "--quiet", "--allow-empty", "-m", "Add Remember helper")
+	runTestGit(t, dir, "tag", "-a", "v0.2.0", "-m", "Remember release")
+	runTestGit(t, dir, "tag", "driver/redis/v0.2.0")
+
+	repo := RepoConfig{Slug: "cache", Title: "Cache", CloneURL: "https://github.com/goforj/cache.git", OutputPath: "libraries/cache.md"}
+	changelog, err := readLibraryChangelog(repo, dir)
+	if err != nil {
+		t.Fatalf("readLibraryChangelog() error = %v", err)
+	}
+	if changelog.Source != "tags" || len(changelog.Releases) != 2 {
+		t.Fatalf("readLibraryChangelog() = %#v, want two tag releases", changelog)
+	}
+	latest := changelog.Releases[0]
+	if latest.Version != "v0.2.0" || latest.Date != "2026-08-01" || latest.Body != "Remember release" {
+		t.Fatalf("latest release = %#v", latest)
+	}
+	if len(latest.Commits) != 1 || latest.Commits[0].Subject != "Add Remember helper" {
+		t.Fatalf("latest release commits = %#v, want only the commit after v0.1.0", latest.Commits)
+	}
+
+	page := renderLibraryChangelog(changelog)
+	for _, want := range []string{
+		`title: "Cache Changelog"`,
+		"# Cache Changelog",
+		"## v0.2.0 {#v0-2-0}",
+		"Released August 1, 2026 · [Tag](https://github.com/goforj/cache/releases/tag/v0.2.0)",
+		"- Add Remember helper ([`" + shortSHA(latest.Commits[0].SHA) + "`](https://github.com/goforj/cache/commit/" + latest.Commits[0].SHA + "))",
+	} {
+		if !strings.Contains(page, want) {
+			t.Fatalf("renderLibraryChangelog() missing %q in:\n%s", want, page)
+		}
+	}
+}
+
+// TestRenderStackChangelog verifies releases from every library are grouped by date, newest first.
+func TestRenderStackChangelog(t *testing.T) {
+	t.Parallel()
+
+	cache := RepoConfig{Slug: "cache", CloneURL: "https://github.com/goforj/cache.git", OutputPath: "libraries/cache.md"}
+	queue := RepoConfig{Slug: "queue", CloneURL: "https://github.com/goforj/queue.git", OutputPath: "libraries/queue.md"}
+	got := renderStackChangelog([]libraryChangelog{
+		{Repo: queue, Releases: []changelogRelease{{Version: "v0.2.1", Date: "2026-08-12", Body: "- Faster workers."}}},
+		{Repo: cache, Releases: []changelogRelease{
+			{Version: "v0.4.0", Date: "2026-08-12", Body: "### Added\n- Locks."},
+			{Version: "v0.3.0", Date: "2026-07-01"},
+		}},
+	})
+
+	august := strings.Index(got, "## August 12, 2026 {#2026-08-12}")
+	july := strings.Index(got, "## July 1, 2026 {#2026-07-01}")
+	if august == -1 || july == -1 || august > july {
+		t.Fatalf("renderStackChangelog() did not order dates newest first:\n%s", got)
+	}
+	cacheEntry := strings.Index(got, "- [cache v0.4.0](/versions/libraries/cache#v0-4-0) · [tag](https://github.com/goforj/cache/releases/tag/v0.4.0) — Locks.")
+	queueEntry := strings.Index(got, "- [queue v0.2.1](/versions/libraries/queue#v0-2-1)")
+	if cacheEntry == -1 || queueEntry == -1 || cacheEntry > queueEntry {
+		t.Fatalf("renderStackChangelog() entries missing or unsorted:\n%s", got)
+	}
+}
+
+// TestDemoteHeadings verifies release note headings nest beneath the generated version heading.
+func TestDemoteHeadings(t *testing.T) {
+	t.Parallel()
+
+	got := demoteHeadings("# Added\n\n```md\n# sample\n```\n\n## Fixed", 3)
+	if got != "### Added\n\n```md\n# sample\n```\n\n#### Fixed" {
+		t.Fatalf("demoteHeadings() =\n%s", got)
+	}
+}
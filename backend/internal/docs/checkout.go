@@ -0,0 +1,63 @@
+package docs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// repoCheckout pairs a registry entry with the directory its source is read from. Synced is false for a --source checkout.
+type repoCheckout struct {
+	Repo   RepoConfig
+	Dir    string
+	Synced bool
+}
+
+// checkoutDir is the cache location docs:generate syncs each repo into.
+func checkoutDir(cacheRoot string, slug string) string {
+	return filepath.Join(cacheRoot, slug)
+}
+
+// resolveCheckouts reuses checkouts synced by docs:generate so read-only commands work offline against the generated commit,
+// and applies each checkout's docs.yaml so every command sees the same merged registry entry. Commands that load Go code
+// pass sources, which widens sparse cache checkouts to their Go files first; only that step may need the network.
+func resolveCheckouts(repos []RepoConfig, cacheRoot string, localSource string, sources bool) ([]repoCheckout, error) {
+	checkouts := make([]repoCheckout, 0, len(repos))
+	for _, repo := range repos {
+		dir := checkoutDir(cacheRoot, repo.Slug)
+		if localSource != "" {
+			dir = localSource
+		}
+		info, err := os.Stat(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, fmt.Errorf("checkout for %s not found in %s (run docs:generate first)", repo.Slug, dir)
+			}
+			return nil, fmt.Errorf("read checkout for %s: %w", repo.Slug, err)
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("checkout for %s at %s is not a directory", repo.Slug, dir)
+		}
+		merged, err := applyRepoDocsConfig(repo, dir)
+		if err != nil {
+			return nil, err
+		}
+		if sources && localSource == "" {
+			if err := ensureSparseSources(merged, dir, repoGitAuth(merged)); err != nil {
+				return nil, err
+			}
+		}
+		checkouts = append(checkouts, repoCheckout{Repo: merged, Dir: dir, Synced: localSource == ""})
+	}
+	return checkouts, nil
+}
+
+// skipSourceDir reports checkout directories that never hold library modules: fixtures, vendored code, examples and tool dirs.
+func skipSourceDir(name string) bool {
+	switch name {
+	case "testdata", "vendor", "examples", "example":
+		return true
+	}
+	return strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")
+}
@@ -0,0 +1,241 @@
+package docs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+)
+
+// libraryGraphDataPath and libraryGraphDotPath hold the site-wide dependency graph for the architecture diagram.
+var (
+	libraryGraphDataPath = filepath.Join(".vitepress", "data", "library-graph.json")
+	libraryGraphDotPath  = filepath.Join(".vitepress", "data", "library-graph.dot")
+)
+
+// libraryModules is the module file view of one checkout: its root module and everything its go.mod files require.
+type libraryModules struct {
+	Repo     RepoConfig
+	Module   string
+	Requires []string
+}
+
+// libraryGraph is the dependency graph between registered libraries, serialized for the architecture diagram.
+type libraryGraph struct {
+	Nodes []libraryGraphNode `json:"nodes"`
+	Edges []libraryGraphEdge `json:"edges"`
+}
+
+type libraryGraphNode struct {
+	Slug   string `json:"slug"`
+	Title  string `json:"title"`
+	Module string `json:"module,omitempty"`
+	Route  string `json:"route"`
+}
+
+type libraryGraphEdge struct {
+	From     string   `json:"from"`
+	To       string   `json:"to"`
+	Requires []string `json:"requires"`
+}
+
+// libraryDependencies is the slice of the graph rendered onto one library page.
+type libraryDependencies struct {
+	DependsOn []RepoConfig
+	UsedBy    []RepoConfig
+}
+
+// readLibraryModules includes nested driver modules because libraries often keep heavier integrations out of the root go.mod.
+func readLibraryModules(repo RepoConfig, dir string) (libraryModules, error) {
+	modules := libraryModules{Repo: repo}
+	requires := map[string]struct{}{}
+	err := filepath.WalkDir(dir, func(current string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if current != dir && skipSourceDir(entry.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if entry.Name() != "go.mod" {
+			return nil
+		}
+		file, err := readGoModFile(filepath.Dir(current))
+		if err != nil || file == nil {
+			return err
+		}
+		if current == filepath.Join(dir, "go.mod") && file.Module != nil {
+			modules.Module = file.Module.Mod.Path
+		}
+		for _, require := range directRequires(file) {
+			requires[require] = struct{}{}
+		}
+		return nil
+	})
+	if err != nil {
+		return modules, fmt.Errorf("read go.mod files for %s: %w", repo.Slug, err)
+	}
+	for require := range requires {
+		modules.Requires = append(modules.Requires, require)
+	}
+	sort.Strings(modules.Requires)
+	return modules, nil
+}
+
+// buildLibraryGraph matches requirements by module prefix so nested driver modules count toward their parent library.
+func buildLibraryGraph(libraries []libraryModules) libraryGraph {
+	graph := libraryGraph{Nodes: []libraryGraphNode{}, Edges: []libraryGraphEdge{}}
+	for _, library := range libraries {
+		graph.Nodes = append(graph.Nodes, libraryGraphNode{
+			Slug:   library.Repo.Slug,
+			Title:  libraryTitle(library.Repo),
+			Module: library.Module,
+			Route:  libraryRoute(library.Repo),
+		})
+	}
+
+	for _, library := range libraries {
+		targets := map[string][]string{}
+		for _, require := range library.Requires {
+			for _, target := range libraries {
+				if target.Repo.Slug == library.Repo.Slug || target.Module == "" {
+					continue
+				}
+				if require == target.Module || strings.HasPrefix(require, target.Module+"/") {
+					targets[target.Repo.Slug] = append(targets[target.Repo.Slug], require)
+				}
+			}
+		}
+		for slug, requires := range targets {
+			graph.Edges = append(graph.Edges, libraryGraphEdge{From: library.Repo.Slug, To: slug, Requires: requires})
+		}
+	}
+
+	sort.Slice(graph.Nodes, func(i, j int) bool { return graph.Nodes[i].Slug < graph.Nodes[j].Slug })
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].From != graph.Edges[j].From {
+			return graph.Edges[i].From < graph.Edges[j].From
+		}
+		return graph.Edges[i].To < graph.Edges[j].To
+	})
+	return graph
+}
+
+// dependenciesFor resolves graph edges back to registry entries so pages link with the merged titles and descriptions.
+func (g libraryGraph) dependenciesFor(slug string, repos []RepoConfig) libraryDependencies {
+	bySlug := map[string]RepoConfig{}
+	for _, repo := range repos {
+		bySlug[repo.Slug] = repo
+	}
+	var deps libraryDependencies
+	for _, edge := range g.Edges {
+		if edge.From == slug {
+			if repo, ok := bySlug[edge.To]; ok {
+				deps.DependsOn = append(deps.DependsOn, repo)
+			}
+		}
+		if edge.To == slug {
+			if repo, ok := bySlug[edge.From]; ok {
+				deps.UsedBy = append(deps.UsedBy, repo)
+			}
+		}
+	}
+	return deps
+}
+
+// appendDependencySections runs after link rewriting so the internal library routes are not turned into GitHub URLs.
+func appendDependencySections(content string, deps libraryDependencies) string {
+	if len(deps.DependsOn) == 0 && len(deps.UsedBy) == 0 {
+		return content
+	}
+	var out strings.Builder
+	out.WriteString(strings.TrimRight(content, "\n"))
+	for _, section := range []struct {
+		title string
+		repos []RepoConfig
+	}{
+		{title: "Depends on", repos: deps.DependsOn},
+		{title: "Used by", repos: deps.UsedBy},
+	} {
+		if len(section.repos) == 0 {
+			continue
+		}
+		fmt.Fprintf(&out, "\n\n## %s\n\n", section.title)
+		for _, repo := range section.repos {
+			fmt.Fprintf(&out, "- [%s](%s)", libraryTitle(repo), libraryRoute(repo))
+			if repo.Description != "" {
+				fmt.Fprintf(&out, " — %s", repo.Description)
+			}
+			out.WriteString("\n")
+		}
+	}
+	return strings.TrimRight(out.String(), "\n") + "\n"
+}
+
+// renderLibraryGraphJSON is stable across runs so the data file only changes when dependencies do.
+func renderLibraryGraphJSON(graph libraryGraph) (string, error) {
+	encoded, err := json.MarshalIndent(graph, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(encoded) + "\n", nil
+}
+
+// renderLibraryGraphDot emits Graphviz input with node URLs pointing at the library pages.
+func renderLibraryGraphDot(graph libraryGraph) string {
+	var out strings.Builder
+	out.WriteString("digraph goforj {\n")
+	out.WriteString("  rankdir=LR;\n")
+	out.WriteString("  node [shape=box];\n")
+	for _, node := range graph.Nodes {
+		fmt.Fprintf(&out, "  %q [label=%q, URL=%q];\n", node.Slug, node.Title, node.Route)
+	}
+	for _, edge := range graph.Edges {
+		fmt.Fprintf(&out, "  %q -> %q;\n", edge.From, edge.To)
+	}
+	out.WriteString("}\n")
+	return out.String()
+}
+
+// collectLibraryModules reads cached checkouts for libraries outside a filtered run so "Used by" stays complete.
+func collectLibraryModules(repos []RepoConfig, synced map[string]libraryModules, cacheRoot string) []libraryModules {
+	libraries := make([]libraryModules, 0, len(repos))
+	for _, repo := range repos {
+		if library, ok := synced[repo.Slug]; ok {
+			libraries = append(libraries, library)
+			continue
+		}
+		dir := checkoutDir(cacheRoot, repo.Slug)
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			continue
+		}
+		merged, err := applyRepoDocsConfig(repo, dir)
+		if err != nil {
+			merged = repo
+		}
+		library, err := readLibraryModules(merged, dir)
+		if err != nil {
+			continue
+		}
+		libraries = append(libraries, library)
+	}
+	return libraries
+}
+
+// directRequires ignores indirect requirements, which describe transitive dependencies rather than library usage.
+func directRequires(file *modfile.File) []string {
+	var paths []string
+	for _, require := range file.Require {
+		if !require.Indirect {
+			paths = append(paths, require.Mod.Path)
+		}
+	}
+	return paths
+}
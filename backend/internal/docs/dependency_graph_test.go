@@ -0,0 +1,79 @@
+package docs
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestReadLibraryModulesIncludesNestedDriverModules verifies driver submodules count as library usage while examples do not.
+func TestReadLibraryModulesIncludesNestedDriverModules(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFiles(t, dir, map[string]string{
+		"go.mod":                  "module github.com/goforj/queue\n\ngo 1.23\n\nrequire (\n\tgithub.com/goforj/events v0.3.0\n\tgithub.com/google/uuid v1.6.0 // indirect\n)\n",
+		"driver/redis/go.mod":     "module github.com/goforj/queue/driver/redis\n\ngo 1.23\n\nrequire github.com/goforj/cache/driver/rediscache v0.2.0\n",
+		"examples/basic/go.mod":   "module example\n\ngo 1.23\n\nrequire github.com/goforj/mail v0.1.0\n",
+		"testdata/fixture/go.mod": "module fixture\n\ngo 1.23\n\nrequire github.com/goforj/crypt v0.1.0\n",
+	})
+
+	got, err := readLibraryModules(RepoConfig{Slug: "queue"}, dir)
+	if err != nil {
+		t.Fatalf("readLibraryModules() error = %v", err)
+	}
+	if got.Module != "github.com/goforj/queue" {
+		t.Fatalf("Module = %q, want root module path", got.Module)
+	}
+	want := "github.com/goforj/cache/driver/rediscache,github.com/goforj/events"
+	if strings.Join(got.Requires, ",") != want {
+		t.Fatalf("Requires = %q, want %q", got.Requires, want)
+	}
+}
+
+// TestBuildLibraryGraphLinksRegisteredLibraries verifies edges only connect registered libraries and render on both pages.
+func TestBuildLibraryGraphLinksRegisteredLibraries(t *testing.T) {
+	t.Parallel()
+
+	queue := RepoConfig{Slug: "queue", Title: "Queue", OutputPath: filepath.Join("libraries", "queue.md")}
+	events := RepoConfig{Slug: "events", Title: "Events", Description: "Typed event bus.", OutputPath: filepath.Join("libraries", "events.md")}
+	cache := RepoConfig{Slug: "cache", Title: "Cache", OutputPath: filepath.Join("libraries", "cache.md")}
+	graph := buildLibraryGraph([]libraryModules{
+		{Repo: queue, Module: "github.com/goforj/queue", Requires: []string{"github.com/goforj/cache/driver/rediscache", "github.com/goforj/events", "github.com/google/uuid"}},
+		{Repo: events, Module: "github.com/goforj/events"},
+		{Repo: cache, Module: "github.com/goforj/cache", Requires: []string{"github.com/goforj/cachex"}},
+	})
+
+	if len(graph.Nodes) != 3 {
+		t.Fatalf("Nodes = %+v, want 3 registered libraries", graph.Nodes)
+	}
+	if len(graph.Edges) != 2 || graph.Edges[0].To != "cache" || graph.Edges[1].To != "events" {
+		t.Fatalf("Edges = %+v, want queue -> cache and queue -> events", graph.Edges)
+	}
+
+	repos := []RepoConfig{queue, events, cache}
+	queuePage := appendDependencySections("# Queue\n", graph.dependenciesFor("queue", repos))
+	if !strings.Contains(queuePage, "## Depends on\n\n- [Cache](/cache)\n- [Events](/events) — Typed event bus.\n") {
+		t.Fatalf("appendDependencySections() queue page missing dependencies:\n%s", queuePage)
+	}
+	eventsPage := appendDependencySections("# Events\n", graph.dependenciesFor("events", repos))
+	if !strings.Contains(eventsPage, "## Used by\n\n- [Queue](/queue)\n") || strings.Contains(eventsPage, "Depends on") {
+		t.Fatalf("appendDependencySections() events page = \n%s", eventsPage)
+	}
+
+	dot := renderLibraryGraphDot(graph)
+	for _, want := range []string{`"queue" [label="Queue", URL="/queue"];`, `"queue" -> "events";`} {
+		if !strings.Contains(dot, want) {
+			t.Fatalf("renderLibraryGraphDot() missing %q in:\n%s", want, dot)
+		}
+	}
+}
+
+// TestAppendDependencySectionsSkipsIsolatedLibraries verifies pages without related libraries are left untouched.
+func TestAppendDependencySectionsSkipsIsolatedLibraries(t *testing.T) {
+	t.Parallel()
+
+	if got := appendDependencySections("# Env\n", libraryDependencies{}); got != "# Env\n" {
+		t.Fatalf("appendDependencySections() = %q, want unchanged content", got)
+	}
+}
@@ -0,0 +1,218 @@
+package docs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// repoDocsConfigPath is where a library repo ships presentation overrides for its docs pages.
+const repoDocsConfigPath = ".goforj/docs.yaml"
+
+var docsPageSlugRegex = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+// RepoDocsConfig is the optional per-repo docs.yaml; empty fields leave the central registry value in place.
+type RepoDocsConfig struct {
+	Title          string              `yaml:"title"`
+	Description    string              `yaml:"description"`
+	Keywords       []string            `yaml:"keywords"`
+	SidebarLabel   string              `yaml:"sidebarLabel"`
+	Readme         string              `yaml:"readme"`
+	Pages          []DocsPage          `yaml:"pages"`
+	FrameworkGuide RepoDocsGuideConfig `yaml:"frameworkGuide"`
+}
+
+// RepoDocsGuideConfig limits framework guide overrides to prose; the guide title and path are docs-site routes.
+type RepoDocsGuideConfig struct {
+	Summary string `yaml:"summary"`
+}
+
+// mergePolicy decides which side owns a field when both the registry and docs.yaml set it.
+type mergePolicy int
+
+const (
+	// registryWins lets docs.yaml fill a field only when the registry leaves it empty.
+	registryWins mergePolicy = iota
+	// repoWins lets a non-empty docs.yaml value replace the registry value.
+	repoWins
+)
+
+// docsConfigPolicy keeps README paths registry-owned because checkouts are read from them, while titles and descriptive
+// copy belong to the library maintainers. Nav and sidebars are keyed on routes, so a new title never moves a page.
+var docsConfigPolicy = struct {
+	Title          mergePolicy
+	Description    mergePolicy
+	Keywords       mergePolicy
+	SidebarLabel   mergePolicy
+	Readme         mergePolicy
+	Pages          mergePolicy
+	FrameworkGuide mergePolicy
+}{
+	Title:          repoWins,
+	Description:    repoWins,
+	Keywords:       repoWins,
+	SidebarLabel:   repoWins,
+	Readme:         registryWins,
+	Pages:          repoWins,
+	FrameworkGuide: repoWins,
+}
+
+// readRepoDocsConfig returns a zero config when the repo does not ship docs.yaml.
+func readRepoDocsConfig(dir string) (RepoDocsConfig, error) {
+	content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(repoDocsConfigPath)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return RepoDocsConfig{}, nil
+		}
+		return RepoDocsConfig{}, fmt.Errorf("read %s: %w", repoDocsConfigPath, err)
+	}
+	return parseRepoDocsConfig(content)
+}
+
+// parseRepoDocsConfig rejects unknown keys so a misspelled override fails the build instead of silently doing nothing.
+func parseRepoDocsConfig(content []byte) (RepoDocsConfig, error) {
+	var config RepoDocsConfig
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&config); err != nil && !errors.Is(err, io.EOF) {
+		return RepoDocsConfig{}, fmt.Errorf("parse %s: %w", repoDocsConfigPath, err)
+	}
+	if err := validateRepoDocsConfig(config); err != nil {
+		return RepoDocsConfig{}, fmt.Errorf("invalid %s: %w", repoDocsConfigPath, err)
+	}
+	return config, nil
+}
+
+// validateRepoDocsConfig keeps repo-supplied paths inside the checkout and extra pages off generated routes.
+func validateRepoDocsConfig(config RepoDocsConfig) error {
+	if config.Readme != "" && !isRepoLocalPath(config.Readme) {
+		return fmt.Errorf("readme %q must be a relative path inside the repo", config.Readme)
+	}
+	seen := map[string]struct{}{}
+	for i, page := range config.Pages {
+		if page.Source == "" || !isRepoLocalPath(page.Source) {
+			return fmt.Errorf("pages[%d].source %q must be a relative path inside the repo", i, page.Source)
+		}
+		if !docsPageSlugRegex.MatchString(page.Slug) {
+			return fmt.Errorf("pages[%d].slug %q must be lowercase words separated by hyphens", i, page.Slug)
+		}
+		if page.Slug == "api" || page.Slug == "examples" {
+			return fmt.Errorf("pages[%d].slug %q is reserved for a generated page", i, page.Slug)
+		}
+		if _, exists := seen[page.Slug]; exists {
+			return fmt.Errorf("pages[%d].slug %q is used more than once", i, page.Slug)
+		}
+		seen[page.Slug] = struct{}{}
+		if strings.TrimSpace(page.Title) == "" {
+			return fmt.Errorf("pages[%d].title is required", i)
+		}
+	}
+	return nil
+}
+
+// isRepoLocalPath reports whether a slash-separated path stays inside the repository root.
+func isRepoLocalPath(value string) bool {
+	if strings.HasPrefix(value, "/") || strings.Contains(value, "\\") {
+		return false
+	}
+	cleaned := path.Clean(value)
+	return cleaned != "." && cleaned != ".." && !strings.HasPrefix(cleaned, "../")
+}
+
+// cleanRepoPath normalizes a validated repo path while keeping an unset value empty.
+func cleanRepoPath(value string) string {
+	if value == "" {
+		return ""
+	}
+	return path.Clean(value)
+}
+
+// mergeRepoDocsConfig applies docs.yaml on top of the registry entry according to docsConfigPolicy.
+func mergeRepoDocsConfig(repo RepoConfig, config RepoDocsConfig) RepoConfig {
+	repo.Title = mergeField(docsConfigPolicy.Title, repo.Title, strings.TrimSpace(config.Title))
+	repo.Description = mergeField(docsConfigPolicy.Description, repo.Description, strings.TrimSpace(config.Description))
+	repo.SidebarLabel = mergeField(docsConfigPolicy.SidebarLabel, repo.SidebarLabel, strings.TrimSpace(config.SidebarLabel))
+	repo.ReadmePath = mergeField(docsConfigPolicy.Readme, repo.ReadmePath, cleanRepoPath(config.Readme))
+	repo.Keywords = mergeList(docsConfigPolicy.Keywords, repo.Keywords, normalizeKeywords(config.Keywords))
+	repo.Pages = mergeList(docsConfigPolicy.Pages, repo.Pages, config.Pages)
+	repo.FrameworkGuide.Summary = mergeField(docsConfigPolicy.FrameworkGuide, repo.FrameworkGuide.Summary, strings.TrimSpace(config.FrameworkGuide.Summary))
+	return repo
+}
+
+func mergeField(policy mergePolicy, registry string, override string) string {
+	if override == "" {
+		return registry
+	}
+	if policy == registryWins && registry != "" {
+		return registry
+	}
+	return override
+}
+
+func mergeList[T any](policy mergePolicy, registry []T, override []T) []T {
+	if len(override) == 0 {
+		return registry
+	}
+	if policy == registryWins && len(registry) > 0 {
+		return registry
+	}
+	return override
+}
+
+// normalizeKeywords drops blanks and duplicates so frontmatter stays stable across cosmetic edits.
+func normalizeKeywords(keywords []string) []string {
+	var normalized []string
+	seen := map[string]struct{}{}
+	for _, keyword := range keywords {
+		keyword = strings.TrimSpace(keyword)
+		key := strings.ToLower(keyword)
+		if keyword == "" {
+			continue
+		}
+		if _, exists := seen[key]; exists {
+			continue
+		}
+		seen[key] = struct{}{}
+		normalized = append(normalized, keyword)
+	}
+	return normalized
+}
+
+// applyRepoDocsConfig reads and merges a checkout's docs.yaml in one step for commands that only need the merged entry.
+func applyRepoDocsConfig(repo RepoConfig, dir string) (RepoConfig, error) {
+	config, err := readRepoDocsConfig(dir)
+	if err != nil {
+		return repo, fmt.Errorf("%s: %w", repo.Slug, err)
+	}
+	return mergeRepoDocsConfig(repo, config), nil
+}
+
+// docsPageOutputPath places extra pages beside the generated API reference under the library's directory.
+func docsPageOutputPath(repo RepoConfig, page DocsPage) string {
+	return filepath.Join(filepath.Dir(repo.OutputPath), libraryPageName(repo), page.Slug+".md")
+}
+
+// transformDocsPage applies the README link rewriting to an extra page without the library-level install and guide sections.
+func transformDocsPage(content string, repo RepoConfig, page DocsPage, rawBase string, meta RepoMetadata) string {
+	sourceDir := sourceDirOf(page.Source)
+	updated := rewriteImageLinks(content, rawBase, sourceDir)
+	updated = rewriteMarkdownLinks(updated, repo, sourceDir)
+	updated = rewriteHeadingAnchors(updated)
+
+	pageRepo := repo
+	pageRepo.Title = page.Title
+	pageRepo.Description = page.Description
+	pageRepo.ReadmePath = page.Source
+	pageRepo.SidebarLabel = ""
+	pageMeta := meta
+	pageMeta.Stats = nil
+	return withFrontmatter(pageRepo, pageMeta, updated)
+}
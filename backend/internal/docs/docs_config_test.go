@@ -0,0 +1,173 @@
+package docs
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TestMergeRepoDocsConfigFollowsPolicy verifies maintainers own titles and descriptive copy while the registry keeps routing fields.
+func TestMergeRepoDocsConfigFollowsPolicy(t *testing.T) {
+	t.Parallel()
+
+	config, err := parseRepoDocsConfig([]byte(`
+title: "Cache: Typed #1"
+description: Typed caching with pluggable drivers.
+keywords: [cache, redis, " Cache ", ""]
+sidebarLabel: Cache
+readme: ./docs/README.md
+pages:
+  - source: docs/drivers.md
+    slug: drivers
+    title: Cache Drivers
+frameworkGuide:
+  summary: Register stores through the App container.
+`))
+	if err != nil {
+		t.Fatalf("parseRepoDocsConfig() error = %v", err)
+	}
+
+	registry := RepoConfig{
+		Slug:        "cache",
+		Title:       "Cache",
+		Description: "Registry description.",
+		ReadmePath:  "README.md",
+		FrameworkGuide: FrameworkGuide{
+			Title:   "Cache in GoForj",
+			Path:    "/framework/cache",
+			Summary: "Registry summary.",
+		},
+	}
+	got := mergeRepoDocsConfig(registry, config)
+
+	if got.Title != "Cache: Typed #1" {
+		t.Fatalf("Title = %q, want docs.yaml title", got.Title)
+	}
+	if got.ReadmePath != "README.md" {
+		t.Fatalf("ReadmePath = %q, want registry path", got.ReadmePath)
+	}
+	if got.Description != "Typed caching with pluggable drivers." {
+		t.Fatalf("Description = %q, want docs.yaml description", got.Description)
+	}
+	if strings.Join(got.Keywords, ",") != "cache,redis" {
+		t.Fatalf("Keywords = %q, want normalized docs.yaml keywords", got.Keywords)
+	}
+	if got.SidebarLabel != "Cache" || len(got.Pages) != 1 || got.Pages[0].Slug != "drivers" {
+		t.Fatalf("mergeRepoDocsConfig() = %+v, want docs.yaml sidebar label and pages", got)
+	}
+	if got.FrameworkGuide.Path != "/framework/cache" || got.FrameworkGuide.Summary != "Register stores through the App container." {
+		t.Fatalf("FrameworkGuide = %+v, want registry route with docs.yaml summary", got.FrameworkGuide)
+	}
+}
+
+// TestMergeRepoDocsConfigFillsEmptyRegistryFields verifies registry-owned fields still accept docs.yaml values when unset.
+func TestMergeRepoDocsConfigFillsEmptyRegistryFields(t *testing.T) {
+	t.Parallel()
+
+	got := mergeRepoDocsConfig(RepoConfig{Slug: "cache"}, RepoDocsConfig{Title: "Cache", Readme: "docs/README.md"})
+	if got.Title != "Cache" || got.ReadmePath != "docs/README.md" {
+		t.Fatalf("mergeRepoDocsConfig() = %+v, want docs.yaml title and readme", got)
+	}
+}
+
+// TestParseRepoDocsConfigRejectsInvalidInput verifies typos and escaping paths fail instead of being ignored.
+func TestParseRepoDocsConfigRejectsInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	for name, input := range map[string]string{
+		"unknown key":     "titel: Cache\n",
+		"escaping readme": "readme: ../README.md\n",
+		"absolute source": "pages:\n  - source: /etc/passwd\n    slug: passwd\n    title: Passwd\n",
+		"bad slug":        "pages:\n  - source: docs/a.md\n    slug: Drivers\n    title: Drivers\n",
+		"reserved slug":   "pages:\n  - source: docs/a.md\n    slug: api\n    title: API\n",
+		"duplicate slug":  "pages:\n  - source: docs/a.md\n    slug: a\n    title: A\n  - source: docs/b.md\n    slug: a\n    title: B\n",
+		"missing title":   "pages:\n  - source: docs/a.md\n    slug: a\n",
+	} {
+		if _, err := parseRepoDocsConfig([]byte(input)); err == nil {
+			t.Fatalf("parseRepoDocsConfig(%s) error = nil, want error", name)
+		}
+	}
+}
+
+// TestReadRepoDocsConfigMissingFile verifies repos without docs.yaml keep their registry entry unchanged.
+func TestReadRepoDocsConfigMissingFile(t *testing.T) {
+	t.Parallel()
+
+	repo := RepoConfig{Slug: "cache", Title: "Cache"}
+	got, err := applyRepoDocsConfig(repo, t.TempDir())
+	if err != nil {
+		t.Fatalf("applyRepoDocsConfig() error = %v", err)
+	}
+	if got.Title != repo.Title || got.Pages != nil {
+		t.Fatalf("applyRepoDocsConfig() = %+v, want unchanged registry entry", got)
+	}
+}
+
+// TestTransformDocsPageResolvesLinksFromSourceDirectory verifies extra pages link relative to their own file like GitHub does.
+func TestTransformDocsPageResolvesLinksFromSourceDirectory(t *testing.T) {
+	t.Parallel()
+
+	repo := RepoConfig{
+		Slug:       "cache",
+		Title:      "Cache",
+		CloneURL:   "https://github.com/goforj/cache.git",
+		Branch:     "main",
+		OutputPath: filepath.Join("libraries", "cache.md"),
+		Keywords:   []string{"cache"},
+	}
+	page := DocsPage{Source: "docs/drivers.md", Slug: "drivers", Title: "Cache Drivers", Description: "Driver matrix."}
+	input := "# Drivers\n\n![Diagram](./img/drivers.png)\n\nSee [Redis](../driver/redis/) and [License](/LICENSE).\n"
+
+	got := transformDocsPage(input, repo, page, rawSourceBase(repo, "main"), RepoMetadata{})
+	for _, want := range []string{
+		"title: \"Cache Drivers\"\n",
+		"description: \"Driver matrix.\"\n",
+		"keywords: [\"cache\"]\n",
+		"editLink: \"https://github.com/goforj/cache/edit/main/docs/drivers.md\"",
+		"(https://raw.githubusercontent.com/goforj/cache/main/docs/img/drivers.png)",
+		"(https://github.com/goforj/cache/tree/main/driver/redis/)",
+		"(https://github.com/goforj/cache/blob/main/LICENSE)",
+		"# Drivers {#drivers}",
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("transformDocsPage() missing %q in:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "## Install") {
+		t.Fatalf("transformDocsPage() added an install section:\n%s", got)
+	}
+	if want := filepath.Join("libraries", "cache", "drivers.md"); docsPageOutputPath(repo, page) != want {
+		t.Fatalf("docsPageOutputPath() = %q, want %q", docsPageOutputPath(repo, page), want)
+	}
+}
+
+// TestRepoDocsConfigTitleReachesFrontmatter verifies a docs.yaml title replaces the registry title and stays valid YAML.
+func TestRepoDocsConfigTitleReachesFrontmatter(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFiles(t, dir, map[string]string{
+		repoDocsConfigPath: "title: '\"Cache\": stores # drivers'\n",
+	})
+	repo, err := applyRepoDocsConfig(RepoConfig{Slug: "cache", Title: "Cache", CloneURL: "https://github.com/goforj/cache.git"}, dir)
+	if err != nil {
+		t.Fatalf("applyRepoDocsConfig() error = %v", err)
+	}
+
+	page := withFrontmatter(repo, RepoMetadata{}, "# Cache\n")
+	frontmatter, _, ok := strings.Cut(strings.TrimPrefix(page, "---\n"), "---\n")
+	if !ok {
+		t.Fatalf("withFrontmatter() = %q, want a frontmatter block", page)
+	}
+	var parsed struct {
+		Title string `yaml:"title"`
+	}
+	if err := yaml.Unmarshal([]byte(frontmatter), &parsed); err != nil {
+		t.Fatalf("withFrontmatter() frontmatter is not valid YAML: %v\n%s", err, frontmatter)
+	}
+	if parsed.Title != `"Cache": stores # drivers` {
+		t.Fatalf("frontmatter title = %q, want the docs.yaml title", parsed.Title)
+	}
+}
@@ -3,10 +3,13 @@ package docs
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/gammazero/workerpool"
 	"github.com/goforj/docs/internal/logger"
@@ -14,10 +17,13 @@ import (
 
 // GenerateCommand pulls repo READMEs and generates docs pages.
 type GenerateCommand struct {
-	Repo   string `name:"repo" help:"Only generate docs for a single repo slug (e.g. cache, queue, str)"`
-	Source string `name:"source" type:"path" help:"Use a local repo checkout as the source (requires --repo)"`
-	Fresh  bool   `name:"fresh" help:"Refresh remote input and bypass the generated-page cache"`
-	logger *logger.AppLogger
+	Repo      string `name:"repo" help:"Only generate docs for a single repo slug (e.g. cache, queue, str)"`
+	Source    string `name:"source" type:"path" help:"Use a local repo checkout as the source (requires --repo)"`
+	Fresh     bool   `name:"fresh" help:"Refresh remote input and bypass the generated-page cache"`
+	FullClone bool   `name:"full-clone" help:"Check out every file instead of a sparse, blob-filtered checkout of the files docs need"`
+	Output    string `name:"output" type:"path" env:"DOCS_OUTPUT_DIR" help:"Docs root to write generated pages into (defaults to ./docs or ../docs)"`
+	CacheDir  string `name:"cache-dir" type:"path" env:"DOCS_CACHE_DIR" help:"Directory for cached repo checkouts and page fingerprints (defaults to the system temp dir)"`
+	logger    *logger.AppLogger
 }
 
 // NewDocsGenerateCommand creates a new GenerateCommand.
@@ -34,219 +40,29 @@ func (c *GenerateCommand) Run() error {
 		return err
 	}
 
-	repos := []RepoConfig{
-		{
-			Slug:        "collection",
-			Title:       "Collections",
-			Description: "Fluent, typed collection operations for Go with explicit mutation behavior.",
-			CloneURL:    "https://github.com/goforj/collection.git",
-			Branch:      "main",
-			OutputPath:  filepath.Join("libraries", "collection.md"),
-		},
-		{
-			Slug:        "str",
-			Title:       "Strings",
-			Description: "Rune-safe string construction, matching, transformation, and inflection helpers.",
-			CloneURL:    "https://github.com/goforj/str.git",
-			Branch:      "main",
-			OutputPath:  filepath.Join("libraries", "strings.md"),
-		},
-		{
-			Slug:        "httpx",
-			Title:       "HTTPX",
-			Description: "HTTP client helpers for typed requests, authentication, retries, and diagnostics.",
-			CloneURL:    "https://github.com/goforj/httpx.git",
-			Branch:      "main",
-			OutputPath:  filepath.Join("libraries", "httpx.md"),
-		},
-		{
-			Slug:        "web",
-			Title:       "Web",
-			Description: "Server-side HTTP contracts, routing, middleware, testing, and an Echo-backed runtime.",
-			CloneURL:    "https://github.com/goforj/web.git",
-			Branch:      "main",
-			OutputPath:  filepath.Join("libraries", "web.md"),
-			FrameworkGuide: FrameworkGuide{
-				Title:   "HTTP Services",
-				Path:    "/applications/http-services",
-				Summary: "GoForj Apps register web routes and controllers through the HTTP runtime. Keep server wiring in framework providers and inject application services into controllers.",
-			},
-		},
-		{
-			Slug:        "execx",
-			Title:       "ExecX",
-			Description: "Command execution helpers with streaming, decoding, and TTY support.",
-			CloneURL:    "https://github.com/goforj/execx.git",
-			Branch:      "main",
-			OutputPath:  filepath.Join("libraries", "execx.md"),
-		},
-		{
-			Slug:        "console",
-			Title:       "Console",
-			Description: "Semantic CLI output, ANSI-aware layout, prompts, loaders, and progress.",
-			CloneURL:    "https://github.com/goforj/console.git",
-			Branch:      "main",
-			OutputPath:  filepath.Join("libraries", "console.md"),
-		},
-		{
-			Slug:        "godump",
-			Title:       "GoDump",
-			Description: "Readable, configurable value dumps for debugging Go programs.",
-			CloneURL:    "https://github.com/goforj/godump.git",
-			Branch:      "main",
-			OutputPath:  filepath.Join("libraries", "godump.md"),
-		},
-		{
-			Slug:        "env",
-			Title:       "Env",
-			Description: "Layered environment loading and typed configuration helpers for Go.",
-			CloneURL:    "https://github.com/goforj/env.git",
-			Branch:      "main",
-			OutputPath:  filepath.Join("libraries", "env.md"),
-		},
-		{
-			Slug:        "scheduler",
-			Title:       "Scheduler",
-			Description: "Recurring work primitives with cron, intervals, overlap protection, and runtime controls.",
-			CloneURL:    "https://github.com/goforj/scheduler.git",
-			Branch:      "main",
-			OutputPath:  filepath.Join("libraries", "scheduler.md"),
-			FrameworkGuide: FrameworkGuide{
-				Title:   "Scheduler",
-				Path:    "/async/scheduler",
-				Summary: "GoForj Apps register schedules in the scheduler runtime and inject the jobs they run. Keep recurring business work in jobs instead of the schedule registry.",
-			},
-		},
-		{
-			Slug:        "queue",
-			Title:       "Queue",
-			Description: "Queued work, workers, retries, workflows, and pluggable backend drivers.",
-			CloneURL:    "https://github.com/goforj/queue.git",
-			Branch:      "main",
-			OutputPath:  filepath.Join("libraries", "queue.md"),
-			FrameworkGuide: FrameworkGuide{
-				Title:   "Queues",
-				Path:    "/async/queues",
-				Summary: "GoForj Apps expose named queues through generated accessors. Dispatch jobs through those accessors and keep backend selection in queue configuration.",
-			},
-		},
-		{
-			Slug:        "events",
-			Title:       "Events",
-			Description: "Typed event publication and subscription with local and distributed transports.",
-			CloneURL:    "https://github.com/goforj/events.git",
-			Branch:      "main",
-			OutputPath:  filepath.Join("libraries", "events.md"),
-			FrameworkGuide: FrameworkGuide{
-				Title:   "Events",
-				Path:    "/async/events",
-				Summary: "GoForj Apps expose named event buses through generated accessors. Publish through those accessors and keep driver selection in event configuration.",
-			},
-		},
-		{
-			Slug:        "mail",
-			Title:       "Mail",
-			Description: "Portable message composition with local, SMTP, and provider delivery drivers.",
-			CloneURL:    "https://github.com/goforj/mail.git",
-			Branch:      "main",
-			OutputPath:  filepath.Join("libraries", "mail.md"),
-			FrameworkGuide: FrameworkGuide{
-				Title:   "Mail",
-				Path:    "/applications/mail",
-				Summary: "GoForj Apps expose named mailers through generated accessors. Send through those accessors and keep transport selection and credentials in configuration.",
-			},
-		},
-		{
-			Slug:        "cache",
-			Title:       "Cache",
-			Description: "One cache API with local, distributed, and database-backed stores.",
-			CloneURL:    "https://github.com/goforj/cache.git",
-			Branch:      "main",
-			OutputPath:  filepath.Join("libraries", "cache.md"),
-			FrameworkGuide: FrameworkGuide{
-				Title:   "Cache Patterns",
-				Path:    "/data/cache-patterns",
-				Summary: "GoForj Apps expose named caches through generated accessors. Use those accessors in application services and keep backend selection in cache configuration.",
-			},
-		},
-		{
-			Slug:        "crypt",
-			Title:       "Crypt",
-			Description: "Encryption helpers with key generation and rotation support.",
-			CloneURL:    "https://github.com/goforj/crypt.git",
-			Branch:      "main",
-			OutputPath:  filepath.Join("libraries", "crypt.md"),
-		},
-		{
-			Slug:        "storage",
-			Title:       "Storage",
-			Description: "Named file and object-storage disks with local and remote drivers.",
-			CloneURL:    "https://github.com/goforj/storage.git",
-			Branch:      "main",
-			OutputPath:  filepath.Join("libraries", "storage.md"),
-			FrameworkGuide: FrameworkGuide{
-				Title:   "Storage Patterns",
-				Path:    "/data/storage-patterns",
-				Summary: "GoForj Apps expose named disks through generated accessors. Use those accessors in application services and keep backend selection in storage configuration.",
-			},
-		},
-		{
-			Slug:        "metrics",
-			Title:       "Metrics",
-			Description: "Counters, gauges, histograms, snapshots, and Prometheus-compatible export.",
-			CloneURL:    "https://github.com/goforj/metrics.git",
-			Branch:      "main",
-			OutputPath:  filepath.Join("libraries", "metrics.md"),
-			FrameworkGuide: FrameworkGuide{
-				Title:   "Metrics",
-				Path:    "/operations/metrics",
-				Summary: "GoForj Apps expose metrics through the observability and HTTP runtime. Keep registration close to the behavior being measured and configure scrape exposure through the App runtime.",
-			},
-		},
-		{
-			Slug:        "wire",
-			Title:       "Wire",
-			Description: "Fast, explicit compile-time dependency injection for Go.",
-			CloneURL:    "https://github.com/goforj/wire.git",
-			Branch:      "main",
-			OutputPath:  filepath.Join("libraries", "wire.md"),
-			ReadmePath:  "README.md",
-			RepoName:    "wire",
-		},
-		{
-			Slug:        "atlas",
-			Title:       "Atlas",
-			Description: "Project context, skills, diagnostics, and MCP tooling for GoForj coding agents.",
-			CloneURL:    "https://github.com/goforj/atlas.git",
-			Branch:      "main",
-			OutputPath:  filepath.Join("libraries", "atlas.md"),
-		},
+	repos, err := selectRepos(defaultRepos(), c.Repo)
+	if err != nil {
+		return err
 	}
 	if c.Repo != "" {
-		filtered := make([]RepoConfig, 0, 1)
-		for _, repo := range repos {
-			if repo.Slug == c.Repo {
-				filtered = append(filtered, repo)
-				break
-			}
-		}
-		if len(filtered) == 0 {
-			return fmt.Errorf("unknown repo %q", c.Repo)
-		}
-		repos = filtered
 		c.logger.Info().Any("repo", c.Repo).Msg("Generating docs for filtered repo")
 	}
 
-	docsRoot, err := findDocsRoot()
+	docsRoot, err := resolveDocsRoot(c.Output)
 	if err != nil {
 		return err
 	}
+	cacheRoot, err := resolveCacheRoot(c.CacheDir)
+	if err != nil {
+		return err
+	}
+	c.logger.Info().Any("output", docsRoot).Any("cache", cacheRoot).Msg("Resolved docs generation paths")
 
-	tempRoot := filepath.Join(os.TempDir(), "goforj-docs")
-	fingerprintRoot := filepath.Join(tempRoot, ".docs-generate-fingerprints")
+	fingerprintRoot := filepath.Join(cacheRoot, fingerprintDirName)
 	wp := workerpool.New(4)
 	var errMu sync.Mutex
 	var firstErr error
+	prepared := make([]preparedRepo, len(repos))
 
 	setErr := func(err error) {
 		if err == nil {
@@ -259,8 +75,8 @@ func (c *GenerateCommand) Run() error {
 		errMu.Unlock()
 	}
 
-	for _, repo := range repos {
-		repo := repo
+	for i, repo := range repos {
+		i, repo := i, repo
 		wp.Submit(func() {
 			errMu.Lock()
 			if firstErr != nil {
@@ -269,7 +85,7 @@ func (c *GenerateCommand) Run() error {
 			}
 			errMu.Unlock()
 
-			repoDir := filepath.Join(tempRoot, repo.Slug)
+			repoDir := checkoutDir(cacheRoot, repo.Slug)
 			if localSource != "" {
 				repoDir = localSource
 				c.logger.Info().Any("repo", repo.Slug).Any("dir", repoDir).Msg("Using local repo source")
@@ -281,8 +97,12 @@ func (c *GenerateCommand) Run() error {
 						return
 					}
 				}
+				if err := checkCloneCredentials(repo); err != nil {
+					setErr(err)
+					return
+				}
 				c.logger.Info().Any("repo", repo.Slug).Any("dir", repoDir).Msg("Syncing repo")
-				action, err := cloneRepo(repo.CloneURL, repoDir, repo.Branch)
+				action, err := c.syncRepo(repo, repoDir)
 				if err != nil {
 					setErr(fmt.Errorf("clone %s: %w", repo.Slug, err))
 					return
@@ -290,62 +110,319 @@ func (c *GenerateCommand) Run() error {
 				c.logger.Info().Any("repo", repo.Slug).Any("action", action).Msg("Repo synced")
 			}
 
-			readmeRelativePath := repo.ReadmePath
-			if readmeRelativePath == "" {
-				readmeRelativePath = "README.md"
+			result, err := prepareRepo(repo, repoDir, localSource == "")
+			if err != nil {
+				setErr(err)
+				return
 			}
-			readmePath := filepath.Join(repoDir, filepath.FromSlash(readmeRelativePath))
-			readmeBytes, err := os.ReadFile(readmePath)
+			stats, err := c.libraryStats(result, docsRoot, localSource == "")
 			if err != nil {
-				setErr(fmt.Errorf("read README %s for %s: %w", readmeRelativePath, repo.Slug, err))
+				setErr(err)
 				return
 			}
+			result.Meta.Stats = &stats
+			c.warnOutdatedTranslations(result, localSource == "")
+			prepared[i] = result
+		})
+	}
+	wp.StopWait()
+	if firstErr != nil {
+		return firstErr
+	}
 
-			rawBase := rawGithubBase(repo, repo.Branch)
-			transformed := transformReadme(string(readmeBytes), repo, rawBase)
-			outputPath := filepath.Join(docsRoot, repo.OutputPath)
-			fingerprint := fingerprintRepoReadme(repo, rawBase, readmeBytes)
-			fingerprintPath := filepath.Join(fingerprintRoot, repo.Slug+".sha256")
-			if !c.Fresh {
-				prev, err := os.ReadFile(fingerprintPath)
-				if err == nil && string(prev) == fingerprint && generatedPageMatches(outputPath, transformed) {
-					c.logger.Info().
-						Any("repo", repo.Slug).
-						Any("fingerprint", shortFingerprint(fingerprint)).
-						Msg("Skipped docs page (README unchanged)")
-					return
-				}
-			}
+	synced := map[string]libraryModules{}
+	for _, result := range prepared {
+		synced[result.Repo.Slug] = result.Modules
+	}
+	allRepos := defaultRepos()
+	libraries := collectLibraryModules(allRepos, synced, cacheRoot)
+	graph := buildLibraryGraph(libraries)
+	linkable := make([]RepoConfig, 0, len(libraries))
+	for _, library := range libraries {
+		linkable = append(linkable, library.Repo)
+	}
 
-			if err := os.MkdirAll(filepath.Dir(outputPath), 0o755); err != nil {
-				setErr(fmt.Errorf("ensure output dir for %s: %w", repo.Slug, err))
-				return
-			}
-			if err := os.WriteFile(outputPath, []byte(transformed), 0o644); err != nil {
-				setErr(fmt.Errorf("write docs output for %s: %w", repo.Slug, err))
-				return
-			}
-			if err := os.MkdirAll(fingerprintRoot, 0o755); err != nil {
-				setErr(fmt.Errorf("ensure fingerprint dir for %s: %w", repo.Slug, err))
-				return
+	for _, result := range prepared {
+		deps := graph.dependenciesFor(result.Repo.Slug, linkable)
+		if err := c.writeLibraryPages(result, deps, docsRoot, fingerprintRoot); err != nil {
+			return err
+		}
+	}
+
+	proofEntries := make([]proofStatsRepo, 0, len(prepared))
+	for _, result := range prepared {
+		proofEntries = append(proofEntries, proofStatsEntry(result.Repo, result.Dir, result.Meta))
+	}
+	// A scratch --output root has no proof evidence to update; the collector owns creating the file.
+	statsPath := filepath.Join(docsRoot, proofStatsPath)
+	statsFile, updated, err := updateProofStats(statsPath, proofEntries, time.Now().UTC().Format(time.DateOnly))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		c.logger.Info().Any("output", statsPath).Msg("Skipped proof stats (no proof-stats.json in output)")
+	case err != nil:
+		return err
+	default:
+		if err := writeGeneratedPage(statsPath, statsFile); err != nil {
+			return fmt.Errorf("write proof stats: %w", err)
+		}
+		c.logger.Info().Any("libraries", len(updated)).Any("output", statsPath).Msg("Updated proof stats")
+	}
+
+	// Graph and example data need every library, so filtered runs leave them untouched.
+	if c.Repo != "" {
+		return nil
+	}
+	graphJSON, err := renderLibraryGraphJSON(graph)
+	if err != nil {
+		return fmt.Errorf("encode library graph: %w", err)
+	}
+	for outputPath, content := range map[string]string{
+		filepath.Join(docsRoot, libraryGraphDataPath): graphJSON,
+		filepath.Join(docsRoot, libraryGraphDotPath):  renderLibraryGraphDot(graph),
+	} {
+		if err := writeGeneratedPage(outputPath, content); err != nil {
+			return fmt.Errorf("write library graph %s: %w", outputPath, err)
+		}
+	}
+	c.logger.Info().Any("libraries", len(graph.Nodes)).Any("edges", len(graph.Edges)).Msg("Generated library dependency graph")
+
+	var exampleLibraries []examplesManifestLibrary
+	for _, result := range prepared {
+		if len(result.Examples) > 0 {
+			exampleLibraries = append(exampleLibraries, examplesManifestLibraryFor(result.Repo, result.Meta, result.Examples))
+		}
+	}
+	manifest, err := renderExamplesManifest(exampleLibraries)
+	if err != nil {
+		return fmt.Errorf("encode examples manifest: %w", err)
+	}
+	if err := writeGeneratedPage(filepath.Join(docsRoot, examplesManifestPath), manifest); err != nil {
+		return fmt.Errorf("write examples manifest: %w", err)
+	}
+	c.logger.Info().Any("libraries", len(exampleLibraries)).Msg("Generated examples manifest")
+
+	return nil
+}
+
+// preparedRepo holds everything read from a synced checkout so pages can be rendered once the cross-library graph is known.
+type preparedRepo struct {
+	Repo         RepoConfig
+	Dir          string
+	Readme       []byte
+	Meta         RepoMetadata
+	Modules      libraryModules
+	Examples     []libraryExample
+	Translations []libraryTranslation
+}
+
+// warnOutdatedTranslations needs per-file history, so cached shallow checkouts are deepened blob-free first.
+// Problems only cost the warning; they never fail generation.
+func (c *GenerateCommand) warnOutdatedTranslations(prepared preparedRepo, cached bool) {
+	if len(prepared.Translations) == 0 || !isGitRepo(prepared.Dir) {
+		return
+	}
+	repo := prepared.Repo
+	if cached {
+		if err := ensureFullHistory(prepared.Dir, repoGitAuth(repo)); err != nil {
+			c.logger.Warn().Any("repo", repo.Slug).Err(err).Msg("Could not fetch history; skipped translation freshness check")
+			return
+		}
+	}
+	readmePath := repo.ReadmePath
+	if readmePath == "" {
+		readmePath = "README.md"
+	}
+	outdated, err := outdatedTranslations(prepared.Dir, readmePath, prepared.Translations)
+	if err != nil {
+		c.logger.Warn().Any("repo", repo.Slug).Err(err).Msg("Could not date translations")
+		return
+	}
+	for _, lang := range outdated {
+		c.logger.Warn().Any("repo", repo.Slug).Any("lang", lang).Any("readme", localizedReadmePath(readmePath, lang)).Msg("Translation is older than the English README")
+	}
+}
+
+// syncRepo prefers a sparse, blob-filtered checkout and falls back to a full shallow clone when the host or local git cannot
+// serve one. A failed update of an existing checkout is returned as is, so a network error never discards the cache.
+func (c *GenerateCommand) syncRepo(repo RepoConfig, repoDir string) (string, error) {
+	auth := repoGitAuth(repo)
+	if c.FullClone {
+		if isGitRepo(repoDir) && isSparseCheckout(repoDir) {
+			if _, err := runGit(auth, "-C", repoDir, "sparse-checkout", "disable"); err != nil {
+				return "", fmt.Errorf("disable sparse checkout: %w", err)
 			}
-			if err := os.WriteFile(fingerprintPath, []byte(fingerprint), 0o644); err != nil {
-				setErr(fmt.Errorf("write fingerprint for %s: %w", repo.Slug, err))
-				return
+		}
+		return cloneRepo(repo.CloneURL, repoDir, repo.Branch, auth)
+	}
+
+	existing := isGitRepo(repoDir)
+	action, err := sparseCloneRepo(repo, repoDir, auth)
+	if err == nil || existing {
+		return action, err
+	}
+	c.logger.Warn().Any("repo", repo.Slug).Err(err).Msg("Sparse clone failed; falling back to a full clone")
+	if err := os.RemoveAll(repoDir); err != nil {
+		return "", fmt.Errorf("clean repo dir: %w", err)
+	}
+	return cloneRepo(repo.CloneURL, repoDir, repo.Branch, auth)
+}
+
+// libraryStats reuses the counts on the page already generated from this commit, so unchanged libraries never fetch Go
+// sources. Otherwise a sparse cache checkout is widened to its Go files for the count and narrowed again afterwards.
+func (c *GenerateCommand) libraryStats(prepared preparedRepo, docsRoot string, cached bool) (RepoStats, error) {
+	repo := prepared.Repo
+	if !c.Fresh {
+		if stats, ok := recordedRepoStats(filepath.Join(docsRoot, repo.OutputPath), prepared.Meta.CommitSHA); ok {
+			return stats, nil
+		}
+	}
+	if cached {
+		auth := repoGitAuth(repo)
+		if err := ensureSparseSources(repo, prepared.Dir, auth); err != nil {
+			return RepoStats{}, err
+		}
+		defer func() {
+			if err := narrowSparseSources(repo, prepared.Dir, auth); err != nil {
+				c.logger.Warn().Any("repo", repo.Slug).Err(err).Msg("Could not narrow checkout after counting stats")
 			}
+		}()
+	}
+	stats, err := countRepoStats(prepared.Dir)
+	if err != nil {
+		return RepoStats{}, fmt.Errorf("count stats for %s: %w", repo.Slug, err)
+	}
+	return stats, nil
+}
+
+// prepareRepo reads the checkout after its docs.yaml has been merged so the README path override is honored. synced marks
+// a cache checkout, whose tags are read from the remote.
+func prepareRepo(repo RepoConfig, repoDir string, synced bool) (preparedRepo, error) {
+	repo, err := applyRepoDocsConfig(repo, repoDir)
+	if err != nil {
+		return preparedRepo{}, err
+	}
+
+	readmeRelativePath := repo.ReadmePath
+	if readmeRelativePath == "" {
+		readmeRelativePath = "README.md"
+	}
+	readmeBytes, err := os.ReadFile(filepath.Join(repoDir, filepath.FromSlash(readmeRelativePath)))
+	if err != nil {
+		return preparedRepo{}, fmt.Errorf("read README %s for %s: %w", readmeRelativePath, repo.Slug, err)
+	}
+
+	rawBase := rawSourceBase(repo, repoBranch(repo))
+	expand := func(content string, sourceDir string) (string, error) {
+		return expandIncludes(content, repoDir, sourceDir, func(content string, includeDir string) string {
+			return rewriteMarkdownLinks(rewriteImageLinks(content, rawBase, includeDir), repo, includeDir)
+		})
+	}
+	readme, err := expand(string(readmeBytes), sourceDirOf(readmeRelativePath))
+	if err != nil {
+		return preparedRepo{}, fmt.Errorf("expand README includes for %s: %w", repo.Slug, err)
+	}
+	readmeBytes = []byte(readme)
+
+	translations, err := loadLibraryTranslations(repoDir, readmeRelativePath, translationLanguages(), expand)
+	if err != nil {
+		return preparedRepo{}, fmt.Errorf("load translations for %s: %w", repo.Slug, err)
+	}
+
+	meta, err := readRepoMetadata(repoDir, repoGitAuth(repo), synced)
+	if err != nil {
+		return preparedRepo{}, fmt.Errorf("read metadata for %s: %w", repo.Slug, err)
+	}
 
+	modules, err := readLibraryModules(repo, repoDir)
+	if err != nil {
+		return preparedRepo{}, err
+	}
+
+	for _, translation := range translations {
+		meta.Languages = append(meta.Languages, translation.Lang)
+	}
+
+	examples, err := loadLibraryExamples(repoDir)
+	if err != nil {
+		return preparedRepo{}, fmt.Errorf("load examples for %s: %w", repo.Slug, err)
+	}
+
+	return preparedRepo{
+		Repo:         repo,
+		Dir:          repoDir,
+		Readme:       readmeBytes,
+		Meta:         meta,
+		Modules:      modules,
+		Examples:     examples,
+		Translations: translations,
+	}, nil
+}
+
+// writeLibraryPages renders the library page and its extra pages, skipping the README write when its fingerprint is unchanged.
+func (c *GenerateCommand) writeLibraryPages(prepared preparedRepo, deps libraryDependencies, docsRoot string, fingerprintRoot string) error {
+	repo := prepared.Repo
+	rawBase := rawSourceBase(repo, repoBranch(repo))
+	for _, page := range repo.Pages {
+		pageBytes, err := os.ReadFile(filepath.Join(prepared.Dir, filepath.FromSlash(page.Source)))
+		if err != nil {
+			return fmt.Errorf("read docs page %s for %s: %w", page.Source, repo.Slug, err)
+		}
+		pagePath := filepath.Join(docsRoot, docsPageOutputPath(repo, page))
+		if err := writeGeneratedPage(pagePath, transformDocsPage(string(pageBytes), repo, page, rawBase, prepared.Meta)); err != nil {
+			return fmt.Errorf("write docs page %s for %s: %w", page.Slug, repo.Slug, err)
+		}
+		c.logger.Info().Any("repo", repo.Slug).Any("page", page.Slug).Any("output", pagePath).Msg("Generated extra docs page")
+	}
+
+	if len(prepared.Examples) > 0 {
+		examplesPath := filepath.Join(docsRoot, examplesOutputPath(repo))
+		if err := writeGeneratedPage(examplesPath, renderExamplesPage(repo, prepared.Meta, prepared.Examples)); err != nil {
+			return fmt.Errorf("write examples page for %s: %w", repo.Slug, err)
+		}
+		c.logger.Info().Any("repo", repo.Slug).Any("examples", len(prepared.Examples)).Any("output", examplesPath).Msg("Generated examples page")
+	}
+
+	for _, translation := range prepared.Translations {
+		translationPath := filepath.Join(docsRoot, translationOutputPath(repo, translation.Lang))
+		if err := writeGeneratedPage(translationPath, transformTranslation(translation, repo, rawBase, prepared.Meta, deps)); err != nil {
+			return fmt.Errorf("write %s translation for %s: %w", translation.Lang, repo.Slug, err)
+		}
+		c.logger.Info().Any("repo", repo.Slug).Any("lang", translation.Lang).Any("output", translationPath).Msg("Generated translated docs page")
+	}
+
+	transformed := transformReadme(string(prepared.Readme), repo, rawBase, prepared.Meta, deps)
+	outputPath := filepath.Join(docsRoot, repo.OutputPath)
+	fingerprint := fingerprintRepoReadme(repo, rawBase, prepared.Readme, deps)
+	fingerprintPath := filepath.Join(fingerprintRoot, repo.Slug+".sha256")
+	if !c.Fresh {
+		prev, err := os.ReadFile(fingerprintPath)
+		if err == nil && string(prev) == fingerprint && generatedPageMatches(outputPath, transformed) {
 			c.logger.Info().
 				Any("repo", repo.Slug).
 				Any("fingerprint", shortFingerprint(fingerprint)).
-				Any("output", outputPath).
-				Msg("Generated docs page")
-		})
+				Msg("Skipped docs page (README unchanged)")
+			return nil
+		}
 	}
-	wp.StopWait()
-	if firstErr != nil {
-		return firstErr
+
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0o755); err != nil {
+		return fmt.Errorf("ensure output dir for %s: %w", repo.Slug, err)
+	}
+	if err := os.WriteFile(outputPath, []byte(transformed), 0o644); err != nil {
+		return fmt.Errorf("write docs output for %s: %w", repo.Slug, err)
+	}
+	if err := os.MkdirAll(fingerprintRoot, 0o755); err != nil {
+		return fmt.Errorf("ensure fingerprint dir for %s: %w", repo.Slug, err)
+	}
+	if err := os.WriteFile(fingerprintPath, []byte(fingerprint), 0o644); err != nil {
+		return fmt.Errorf("write fingerprint for %s: %w", repo.Slug, err)
 	}
 
+	c.logger.Info().
+		Any("repo", repo.Slug).
+		Any("fingerprint", shortFingerprint(fingerprint)).
+		Any("output", outputPath).
+		Msg("Generated docs page")
 	return nil
 }
 
@@ -380,9 +457,9 @@ func resolveLocalSource(repoSlug, source string) (string, error) {
 }
 
 // fingerprintRepoReadme includes a transform version so importer fixes refresh unchanged upstream READMEs.
-func fingerprintRepoReadme(repo RepoConfig, rawBase string, readme []byte) string {
+func fingerprintRepoReadme(repo RepoConfig, rawBase string, readme []byte, deps libraryDependencies) string {
 	sum := sha256.New()
-	_, _ = sum.Write([]byte("docs-generate-readme-fingerprint:v6\n"))
+	_, _ = sum.Write([]byte("docs-generate-readme-fingerprint:v11\n"))
 	for _, value := range []string{
 		repo.Slug,
 		repo.Title,
@@ -391,7 +468,12 @@ func fingerprintRepoReadme(repo RepoConfig, rawBase string, readme []byte) strin
 		repo.Branch,
 		repo.OutputPath,
 		repo.ReadmePath,
+		string(repo.Host),
+		repo.HostURL,
+		repo.Owner,
 		repo.RepoName,
+		strings.Join(repo.Keywords, "\x1f"),
+		repo.SidebarLabel,
 		repo.FrameworkGuide.Title,
 		repo.FrameworkGuide.Path,
 		repo.FrameworkGuide.Summary,
@@ -400,6 +482,18 @@ func fingerprintRepoReadme(repo RepoConfig, rawBase string, readme []byte) strin
 		_, _ = sum.Write([]byte(value))
 		_, _ = sum.Write([]byte{0})
 	}
+	for _, section := range []struct {
+		name  string
+		repos []RepoConfig
+	}{
+		{name: "depends-on", repos: deps.DependsOn},
+		{name: "used-by", repos: deps.UsedBy},
+	} {
+		for _, related := range section.repos {
+			_, _ = sum.Write([]byte(section.name + "\x1f" + related.Slug + "\x1f" + related.Title + "\x1f" + related.Description))
+			_, _ = sum.Write([]byte{0})
+		}
+	}
 	_, _ = sum.Write(readme)
 	return hex.EncodeToString(sum.Sum(nil))
 }
@@ -5,6 +5,8 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/goforj/docs/internal/logger"
 )
 
 // TestGeneratedPageMatches verifies cache reuse requires the output file to contain the current transformation.
@@ -26,6 +28,34 @@ func TestGeneratedPageMatches(t *testing.T) {
 	}
 }
 
+// TestGenerateIntoEmptyOutput verifies a scratch --output root builds without proof-stats.json, which only the docs tree holds.
+func TestGenerateIntoEmptyOutput(t *testing.T) {
+	t.Parallel()
+
+	source := t.TempDir()
+	writeTestFiles(t, source, map[string]string{
+		"README.md":     "# Cache\n\nTyped caching.\n",
+		"go.mod":        "module github.com/goforj/cache\n\ngo 1.24\n",
+		"cache.go":      "package cache\n\nfunc New() {}\n",
+		"cache_test.go": "package cache\n\nimport \"testing\"\n\nfunc TestNew(t *testing.T) {}\n",
+	})
+	output := filepath.Join(t.TempDir(), "docs")
+	command := &GenerateCommand{Repo: "cache", Source: source, Output: output, CacheDir: t.TempDir(), logger: logger.NewSilentLogger()}
+	if err := command.Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	page, err := os.ReadFile(filepath.Join(output, "libraries", "cache.md"))
+	if err != nil {
+		t.Fatalf("read generated page: %v", err)
+	}
+	if !strings.Contains(string(page), "testFunctions: 1\n") {
+		t.Fatalf("generated page =\n%s\nwant stats frontmatter", page)
+	}
+	if _, err := os.Stat(filepath.Join(output, proofStatsPath)); !os.IsNotExist(err) {
+		t.Fatalf("stat proof stats error = %v, want the file left uncreated", err)
+	}
+}
+
 // TestResolveLocalSource verifies that local generation is explicit and limited to existing directories.
 func TestResolveLocalSource(t *testing.T) {
 	t.Parallel()
@@ -80,7 +110,7 @@ func TestFingerprintRepoReadmeIncludesGeneratedPageConfig(t *testing.T) {
 	}
 	rawBase := "https://raw.githubusercontent.com/goforj/queue/main/"
 	readme := []byte("# Queue\n")
-	wantDifferentFrom := fingerprintRepoReadme(repo, rawBase, readme)
+	wantDifferentFrom := fingerprintRepoReadme(repo, rawBase, readme, libraryDependencies{})
 
 	tests := []struct {
 		name   string
@@ -92,14 +122,36 @@ func TestFingerprintRepoReadmeIncludesGeneratedPageConfig(t *testing.T) {
 		{name: "guide title", mutate: func(repo *RepoConfig) { repo.FrameworkGuide.Title = "Queue Apps" }},
 		{name: "guide path", mutate: func(repo *RepoConfig) { repo.FrameworkGuide.Path = "/applications/queues" }},
 		{name: "guide summary", mutate: func(repo *RepoConfig) { repo.FrameworkGuide.Summary = "Updated queue integration." }},
+		{name: "keywords", mutate: func(repo *RepoConfig) { repo.Keywords = []string{"jobs"} }},
+		{name: "sidebar label", mutate: func(repo *RepoConfig) { repo.SidebarLabel = "Jobs" }},
+		{name: "host", mutate: func(repo *RepoConfig) { repo.Host = HostGitLab }},
+		{name: "host URL", mutate: func(repo *RepoConfig) { repo.HostURL = "https://git.example.com" }},
+		{name: "owner", mutate: func(repo *RepoConfig) { repo.Owner = "platform" }},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			changed := repo
 			test.mutate(&changed)
-			if got := fingerprintRepoReadme(changed, rawBase, readme); got == wantDifferentFrom {
+			if got := fingerprintRepoReadme(changed, rawBase, readme, libraryDependencies{}); got == wantDifferentFrom {
 				t.Fatalf("fingerprintRepoReadme() did not change after updating %s", test.name)
 			}
 		})
 	}
 }
+
+// TestFingerprintRepoReadmeIncludesDependencies verifies dependency sections refresh when another library starts or stops using this one.
+func TestFingerprintRepoReadmeIncludesDependencies(t *testing.T) {
+	t.Parallel()
+
+	repo := RepoConfig{Slug: "events", OutputPath: "libraries/events.md"}
+	rawBase := "https://raw.githubusercontent.com/goforj/events/main/"
+	readme := []byte("# Events\n")
+	queue := RepoConfig{Slug: "queue", Title: "Queue"}
+
+	none := fingerprintRepoReadme(repo, rawBase, readme, libraryDependencies{})
+	usedBy := fingerprintRepoReadme(repo, rawBase, readme, libraryDependencies{UsedBy: []RepoConfig{queue}})
+	dependsOn := fingerprintRepoReadme(repo, rawBase, readme, libraryDependencies{DependsOn: []RepoConfig{queue}})
+	if none == usedBy || usedBy == dependsOn {
+		t.Fatal("fingerprintRepoReadme() did not distinguish dependency sections")
+	}
+}
@@ -0,0 +1,570 @@
+package docs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// driversPagePath is the rendered matrix; the drivers block of proofStatsPath is the driver data the site imports.
+var (
+	driversPagePath = "drivers.md"
+	proofStatsPath  = filepath.Join(".vitepress", "data", "proof-stats.json")
+)
+
+var driverTableRowRegex = regexp.MustCompile("^\\|\\s*`([^`]+)`\\s*\\|\\s*(.*?)\\s*\\|\\s*$")
+
+// DriverSpec tells docs:drivers which type is a library's driver contract and how driver package names map to published names.
+type DriverSpec struct {
+	// Package is the slash-separated directory of the package declaring Type, relative to the repo root.
+	Package string
+	// Type is an interface implemented by driver types, or a string type whose constants name built-in drivers.
+	Type string
+	// Prefix and Suffix are trimmed from driver package directory names (mailsmtp, redisqueue) and,
+	// when set, restrict discovery to directories that carry them.
+	Prefix string
+	Suffix string
+	// Aliases maps a derived name to the name the docs publish.
+	Aliases map[string]string
+}
+
+// libraryDriver is one discovered driver; Package is empty for drivers built into the contract package.
+type libraryDriver struct {
+	Name    string
+	Package string
+	BuiltIn bool
+}
+
+// discoverLibraryDrivers type-checks the checkout without network access; imports outside the repo resolve to empty
+// packages, so only method names and arity are compared when deciding whether a type implements the contract.
+func discoverLibraryDrivers(repo RepoConfig, dir string) ([]libraryDriver, error) {
+	spec := repo.Drivers
+	if spec == nil {
+		return nil, nil
+	}
+	loader, err := newDriverLoader(dir)
+	if err != nil {
+		return nil, err
+	}
+	if loader.rootModule == "" {
+		return nil, fmt.Errorf("%s: no go.mod at the checkout root", repo.Slug)
+	}
+
+	specDir := filepath.Join(dir, filepath.FromSlash(spec.Package))
+	specPath := joinImportPath(loader.rootModule, spec.Package)
+	specPkg := loader.load(specPath, specDir)
+	object, ok := specPkg.Scope().Lookup(spec.Type).(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("%s: driver type %s.%s not found", repo.Slug, specPkg.Name(), spec.Type)
+	}
+	contract := object.Type()
+	iface, isInterface := contract.Underlying().(*types.Interface)
+	basic, isBasic := contract.Underlying().(*types.Basic)
+	if !isInterface && !(isBasic && basic.Info()&types.IsString != 0) {
+		return nil, fmt.Errorf("%s: driver type %s.%s must be an interface or a string type", repo.Slug, specPkg.Name(), spec.Type)
+	}
+	if !isInterface && spec.Prefix == "" && spec.Suffix == "" {
+		return nil, fmt.Errorf("%s: string driver type %s.%s needs a package prefix or suffix", repo.Slug, specPkg.Name(), spec.Type)
+	}
+
+	var drivers []libraryDriver
+	seen := map[string]struct{}{}
+	add := func(driver libraryDriver) {
+		if driver.Name == "" {
+			return
+		}
+		if _, exists := seen[driver.Name]; exists {
+			return
+		}
+		seen[driver.Name] = struct{}{}
+		drivers = append(drivers, driver)
+	}
+
+	for _, name := range declarationOrder(loader.fset, specPkg.Scope()) {
+		switch member := specPkg.Scope().Lookup(name).(type) {
+		case *types.TypeName:
+			if isInterface && member != object && implementsByName(member.Type(), iface) {
+				add(libraryDriver{Name: spec.name(builtInDriverName(member.Name())), BuiltIn: true})
+			}
+		case *types.Const:
+			if !isInterface && types.Identical(member.Type(), contract) && member.Val().Kind() == constant.String {
+				add(libraryDriver{Name: spec.name(constant.StringVal(member.Val())), BuiltIn: true})
+			}
+		}
+	}
+
+	var packaged []libraryDriver
+	err = filepath.WalkDir(dir, func(current string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() {
+			return nil
+		}
+		name := entry.Name()
+		if current != dir && (skipSourceDir(name) || name == "internal") {
+			return filepath.SkipDir
+		}
+		if current == dir || current == specDir || !spec.matchesDir(name) {
+			return nil
+		}
+		importPath := loader.importPath(current)
+		if importPath == "" {
+			return nil
+		}
+		pkg := loader.load(importPath, current)
+		if !driverPackageMatches(pkg, specPkg, iface) {
+			return nil
+		}
+		packaged = append(packaged, libraryDriver{Name: spec.name(spec.trimAffixes(name)), Package: importPath})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: walk driver packages: %w", repo.Slug, err)
+	}
+	sort.Slice(packaged, func(i, j int) bool { return packaged[i].Name < packaged[j].Name })
+	for _, driver := range packaged {
+		add(driver)
+	}
+	return drivers, nil
+}
+
+// driverPackageMatches counts interface contracts by implementation and string contracts by importing the contract package.
+func driverPackageMatches(pkg *types.Package, specPkg *types.Package, iface *types.Interface) bool {
+	if iface == nil {
+		for _, imported := range pkg.Imports() {
+			if imported.Path() == specPkg.Path() {
+				return true
+			}
+		}
+		return false
+	}
+	for _, name := range pkg.Scope().Names() {
+		member, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+		if ok && member.Exported() && implementsByName(member.Type(), iface) {
+			return true
+		}
+	}
+	return false
+}
+
+// implementsByName tolerates unresolved external types by matching method names and parameter and result counts.
+func implementsByName(typ types.Type, iface *types.Interface) bool {
+	if _, isInterface := typ.Underlying().(*types.Interface); isInterface || iface.NumMethods() == 0 {
+		return false
+	}
+	pointer := types.NewPointer(typ)
+	for i := 0; i < iface.NumMethods(); i++ {
+		want := iface.Method(i)
+		object, _, _ := types.LookupFieldOrMethod(pointer, false, want.Pkg(), want.Name())
+		method, ok := object.(*types.Func)
+		if !ok {
+			return false
+		}
+		got := method.Type().(*types.Signature)
+		wantSig := want.Type().(*types.Signature)
+		if got.Params().Len() != wantSig.Params().Len() || got.Results().Len() != wantSig.Results().Len() {
+			return false
+		}
+	}
+	return true
+}
+
+// builtInDriverName turns contract-package types such as nullDriver or WorkerpoolDriver into published names.
+func builtInDriverName(typeName string) string {
+	return strings.TrimSuffix(strings.ToLower(typeName), "driver")
+}
+
+func (s DriverSpec) matchesDir(name string) bool {
+	if s.Prefix != "" && !strings.HasPrefix(name, s.Prefix) {
+		return false
+	}
+	if s.Suffix != "" && !strings.HasSuffix(name, s.Suffix) {
+		return false
+	}
+	return true
+}
+
+func (s DriverSpec) trimAffixes(name string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(name, s.Prefix), s.Suffix)
+}
+
+func (s DriverSpec) name(derived string) string {
+	if alias, ok := s.Aliases[derived]; ok {
+		return alias
+	}
+	return derived
+}
+
+// declarationOrder lists package-level names in source order so built-in drivers keep the order their authors chose.
+func declarationOrder(fset *token.FileSet, scope *types.Scope) []string {
+	names := scope.Names()
+	sort.SliceStable(names, func(i, j int) bool {
+		left := fset.Position(scope.Lookup(names[i]).Pos())
+		right := fset.Position(scope.Lookup(names[j]).Pos())
+		if left.Filename != right.Filename {
+			return left.Filename < right.Filename
+		}
+		return left.Offset < right.Offset
+	})
+	return names
+}
+
+func joinImportPath(module string, rel string) string {
+	if rel == "" || rel == "." {
+		return module
+	}
+	return path.Join(module, rel)
+}
+
+// driverLoader type-checks checkout packages on demand and stubs every import that lives outside the checkout.
+type driverLoader struct {
+	root       string
+	rootModule string
+	modules    map[string]string
+	fset       *token.FileSet
+	packages   map[string]*types.Package
+	loading    map[string]bool
+}
+
+func newDriverLoader(dir string) (*driverLoader, error) {
+	loader := &driverLoader{
+		root:     dir,
+		modules:  map[string]string{},
+		fset:     token.NewFileSet(),
+		packages: map[string]*types.Package{},
+		loading:  map[string]bool{},
+	}
+	err := filepath.WalkDir(dir, func(current string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if current != dir && skipSourceDir(entry.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if entry.Name() != "go.mod" {
+			return nil
+		}
+		file, err := readGoModFile(filepath.Dir(current))
+		if err != nil || file == nil || file.Module == nil {
+			return err
+		}
+		loader.modules[file.Module.Mod.Path] = filepath.Dir(current)
+		if filepath.Dir(current) == dir {
+			loader.rootModule = file.Module.Mod.Path
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read go.mod files: %w", err)
+	}
+	return loader, nil
+}
+
+// Import implements types.Importer.
+func (l *driverLoader) Import(importPath string) (*types.Package, error) {
+	if dir := l.dirFor(importPath); dir != "" {
+		return l.load(importPath, dir), nil
+	}
+	return stubPackage(importPath), nil
+}
+
+// dirFor maps an import path to the checkout directory of the longest matching module.
+func (l *driverLoader) dirFor(importPath string) string {
+	best := ""
+	for module := range l.modules {
+		if (importPath == module || strings.HasPrefix(importPath, module+"/")) && len(module) > len(best) {
+			best = module
+		}
+	}
+	if best == "" {
+		return ""
+	}
+	return filepath.Join(l.modules[best], filepath.FromSlash(strings.TrimPrefix(strings.TrimPrefix(importPath, best), "/")))
+}
+
+// importPath is the inverse of dirFor for directories inside the checkout.
+func (l *driverLoader) importPath(dir string) string {
+	best, bestDir := "", ""
+	for module, moduleDir := range l.modules {
+		rel, err := filepath.Rel(moduleDir, dir)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		if len(moduleDir) > len(bestDir) {
+			best, bestDir = joinImportPath(module, filepath.ToSlash(rel)), moduleDir
+		}
+	}
+	return best
+}
+
+// load never fails: unreadable or cyclic packages become stubs so one broken driver cannot hide the others.
+func (l *driverLoader) load(importPath string, dir string) *types.Package {
+	if pkg, ok := l.packages[importPath]; ok {
+		return pkg
+	}
+	if l.loading[importPath] {
+		return stubPackage(importPath)
+	}
+	l.loading[importPath] = true
+	defer delete(l.loading, importPath)
+
+	buildPkg, err := build.Default.ImportDir(dir, 0)
+	if err != nil {
+		pkg := stubPackage(importPath)
+		l.packages[importPath] = pkg
+		return pkg
+	}
+	files := make([]*ast.File, 0, len(buildPkg.GoFiles))
+	for _, name := range buildPkg.GoFiles {
+		file, err := parser.ParseFile(l.fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err == nil {
+			files = append(files, file)
+		}
+	}
+	config := types.Config{Importer: l, Error: func(error) {}}
+	pkg, _ := config.Check(importPath, l.fset, files, nil)
+	l.packages[importPath] = pkg
+	return pkg
+}
+
+// stubPackage stands in for external dependencies, which are not needed to compare method sets by name.
+func stubPackage(importPath string) *types.Package {
+	pkg := types.NewPackage(importPath, path.Base(importPath))
+	pkg.MarkComplete()
+	return pkg
+}
+
+// driverTableMarkers delimit the generated table for one library inside the hand-written drivers page.
+func driverTableMarkers(slug string) (string, string) {
+	return "<!-- docs:drivers:" + slug + " -->", "<!-- /docs:drivers:" + slug + " -->"
+}
+
+// driverTableBlock returns the lines between a library's markers.
+func driverTableBlock(page string, slug string) (string, int, int, error) {
+	start, end := driverTableMarkers(slug)
+	startIndex := strings.Index(page, start)
+	endIndex := strings.Index(page, end)
+	if startIndex == -1 || endIndex == -1 || endIndex < startIndex {
+		return "", 0, 0, fmt.Errorf("%s has no %s ... %s block", driversPagePath, start, end)
+	}
+	contentStart := startIndex + len(start)
+	return page[contentStart:endIndex], contentStart, endIndex, nil
+}
+
+// parseDriverTable reads the hand-written descriptions so regenerating the table keeps them.
+func parseDriverTable(block string) ([]string, map[string]string) {
+	var names []string
+	descriptions := map[string]string{}
+	for _, line := range strings.Split(block, "\n") {
+		matches := driverTableRowRegex.FindStringSubmatch(strings.TrimSpace(line))
+		if matches == nil {
+			continue
+		}
+		names = append(names, matches[1])
+		descriptions[matches[1]] = matches[2]
+	}
+	return names, descriptions
+}
+
+// orderDrivers keeps the published order for known drivers and appends new ones in discovery order.
+func orderDrivers(drivers []libraryDriver, existingOrder []string) []libraryDriver {
+	byName := map[string]libraryDriver{}
+	for _, driver := range drivers {
+		byName[driver.Name] = driver
+	}
+	var ordered []libraryDriver
+	for _, name := range existingOrder {
+		if driver, ok := byName[name]; ok {
+			ordered = append(ordered, driver)
+			delete(byName, name)
+		}
+	}
+	for _, driver := range drivers {
+		if _, ok := byName[driver.Name]; ok {
+			ordered = append(ordered, driver)
+		}
+	}
+	return ordered
+}
+
+// renderDriverTable keeps the existing row order for known drivers and appends new ones with a placeholder description.
+func renderDriverTable(drivers []libraryDriver, existingOrder []string, descriptions map[string]string) string {
+	ordered := orderDrivers(drivers, existingOrder)
+	var out strings.Builder
+	out.WriteString("\n| Driver | What it is for |\n| --- | --- |\n")
+	for _, driver := range ordered {
+		description := descriptions[driver.Name]
+		if description == "" {
+			description = "Built into the root module"
+			if driver.Package != "" {
+				description = fmt.Sprintf("Driver module `%s`", driver.Package)
+			}
+		}
+		fmt.Fprintf(&out, "| `%s` | %s |\n", driver.Name, description)
+	}
+	return out.String()
+}
+
+// updateDriversPage regenerates one library's table in place and leaves the surrounding prose untouched.
+func updateDriversPage(page string, slug string, drivers []libraryDriver) (string, error) {
+	block, start, end, err := driverTableBlock(page, slug)
+	if err != nil {
+		return "", err
+	}
+	order, descriptions := parseDriverTable(block)
+	return page[:start] + renderDriverTable(drivers, order, descriptions) + page[end:], nil
+}
+
+// proofDriverList is one library's entry in the proof-stats.json drivers block, which the site imports as driver data.
+type proofDriverList struct {
+	Slug  string
+	Names []string
+}
+
+// updateProofDrivers writes the detected drivers into the proof-stats.json drivers block, keeping library and driver
+// order, and recomputes totals.drivers. The collection date moves only when the evidence changes.
+func updateProofDrivers(statsPath string, detected map[string][]libraryDriver, today string) (string, error) {
+	content, err := os.ReadFile(statsPath)
+	if err != nil {
+		return "", fmt.Errorf("read %s: %w", proofStatsPath, err)
+	}
+	var stats proofStats
+	if err := json.Unmarshal(content, &stats); err != nil {
+		return "", fmt.Errorf("parse %s: %w", proofStatsPath, err)
+	}
+	lists, err := parseProofDrivers(stats.Drivers)
+	if err != nil {
+		return "", fmt.Errorf("parse %s drivers: %w", proofStatsPath, err)
+	}
+	before, err := renderProofStats(stats)
+	if err != nil {
+		return "", err
+	}
+
+	index := map[string]int{}
+	for i, list := range lists {
+		index[list.Slug] = i
+	}
+	for _, slug := range sortedKeys(detected) {
+		i, ok := index[slug]
+		if !ok {
+			i = len(lists)
+			lists = append(lists, proofDriverList{Slug: slug})
+		}
+		names := []string{}
+		for _, driver := range orderDrivers(detected[slug], lists[i].Names) {
+			names = append(names, driver.Name)
+		}
+		lists[i].Names = names
+	}
+	stats.Totals.Drivers = 0
+	for _, list := range lists {
+		stats.Totals.Drivers += len(list.Names)
+	}
+	if stats.Drivers, err = renderProofDrivers(lists); err != nil {
+		return "", err
+	}
+
+	rendered, err := renderProofStats(stats)
+	if err != nil {
+		return "", err
+	}
+	if rendered != before {
+		stats.GeneratedAt = today
+		return renderProofStats(stats)
+	}
+	return rendered, nil
+}
+
+// parseProofDrivers reads the drivers block in file order, which decoding into a map would lose.
+func parseProofDrivers(raw json.RawMessage) ([]proofDriverList, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+	var lists []proofDriverList
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		slug, _ := key.(string)
+		var names []string
+		if err := decoder.Decode(&names); err != nil {
+			return nil, fmt.Errorf("%s: %w", slug, err)
+		}
+		lists = append(lists, proofDriverList{Slug: slug, Names: names})
+	}
+	return lists, nil
+}
+
+func renderProofDrivers(lists []proofDriverList) (json.RawMessage, error) {
+	var out bytes.Buffer
+	out.WriteByte('{')
+	for i, list := range lists {
+		if i > 0 {
+			out.WriteByte(',')
+		}
+		key, err := json.Marshal(list.Slug)
+		if err != nil {
+			return nil, err
+		}
+		names, err := json.Marshal(list.Names)
+		if err != nil {
+			return nil, err
+		}
+		out.Write(key)
+		out.WriteByte(':')
+		out.Write(names)
+	}
+	out.WriteByte('}')
+	return out.Bytes(), nil
+}
+
+// compareDriverNames describes how a hand-maintained list differs from the drivers found in code.
+func compareDriverNames(listed []string, drivers []libraryDriver) (missing []string, extra []string) {
+	found := map[string]struct{}{}
+	for _, driver := range drivers {
+		found[driver.Name] = struct{}{}
+	}
+	seen := map[string]struct{}{}
+	for _, name := range listed {
+		seen[name] = struct{}{}
+		if _, ok := found[name]; !ok {
+			extra = append(extra, name)
+		}
+	}
+	for _, driver := range drivers {
+		if _, ok := seen[driver.Name]; !ok {
+			missing = append(missing, driver.Name)
+		}
+	}
+	return missing, extra
+}
+
+// sortedKeys gives map-driven reports a stable order.
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
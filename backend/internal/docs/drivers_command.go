@@ -0,0 +1,185 @@
+package docs
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/goforj/docs/internal/logger"
+)
+
+// DriversCommand renders the driver matrix from the driver packages found in synced library checkouts.
+type DriversCommand struct {
+	Repo     string `name:"repo" help:"Only discover drivers for a single repo slug (e.g. cache, queue)"`
+	Source   string `name:"source" type:"path" help:"Use a local repo checkout as the source (requires --repo)"`
+	Output   string `name:"output" type:"path" env:"DOCS_OUTPUT_DIR" help:"Docs root holding drivers.md and the data directory (defaults to ./docs or ../docs)"`
+	CacheDir string `name:"cache-dir" type:"path" env:"DOCS_CACHE_DIR" help:"Directory holding the checkouts synced by docs:generate"`
+	Check    bool   `name:"check" help:"Fail when drivers.md or the proof-stats.json drivers block disagree with the code instead of writing"`
+	logger   *logger.AppLogger
+}
+
+// NewDocsDriversCommand creates a new DriversCommand.
+func NewDocsDriversCommand(logger *logger.AppLogger) *DriversCommand {
+	return &DriversCommand{
+		logger: logger,
+	}
+}
+
+// Run discovers drivers for every library with a driver contract, then writes or checks the matrix.
+func (c *DriversCommand) Run() error {
+	localSource, err := resolveLocalSource(c.Repo, c.Source)
+	if err != nil {
+		return err
+	}
+	repos, err := selectRepos(driverRepos(defaultRepos()), c.Repo)
+	if err != nil {
+		return err
+	}
+	docsRoot, err := resolveDocsRoot(c.Output)
+	if err != nil {
+		return err
+	}
+	cacheRoot, err := resolveCacheRoot(c.CacheDir)
+	if err != nil {
+		return err
+	}
+	c.logger.Info().Any("output", docsRoot).Any("cache", cacheRoot).Msg("Resolved driver matrix paths")
+
+	checkouts, err := resolveCheckouts(repos, cacheRoot, localSource, true)
+	if err != nil {
+		return err
+	}
+
+	detected := map[string][]libraryDriver{}
+	for _, checkout := range checkouts {
+		drivers, err := discoverLibraryDrivers(checkout.Repo, checkout.Dir)
+		if err != nil {
+			return err
+		}
+		if len(drivers) == 0 {
+			return fmt.Errorf("%s: no drivers implement %s", checkout.Repo.Slug, checkout.Repo.Drivers.Type)
+		}
+		detected[checkout.Repo.Slug] = drivers
+		c.logger.Info().Any("repo", checkout.Repo.Slug).Any("drivers", len(drivers)).Msg("Discovered drivers")
+	}
+
+	if c.Check {
+		problems, err := checkDriverLists(docsRoot, detected)
+		if err != nil {
+			return err
+		}
+		for _, problem := range problems {
+			fmt.Println(problem)
+		}
+		if len(problems) > 0 {
+			return fmt.Errorf("driver matrix disagrees with library code in %d place(s); run docs:drivers", len(problems))
+		}
+		c.logger.Info().Any("libraries", len(detected)).Msg("Driver matrix matches library code")
+		return nil
+	}
+
+	pagePath := filepath.Join(docsRoot, driversPagePath)
+	page, err := os.ReadFile(pagePath)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", driversPagePath, err)
+	}
+	updated := string(page)
+	for _, repo := range repos {
+		updated, err = updateDriversPage(updated, repo.Slug, detected[repo.Slug])
+		if err != nil {
+			return err
+		}
+	}
+	if err := writeGeneratedPage(pagePath, updated); err != nil {
+		return fmt.Errorf("write %s: %w", driversPagePath, err)
+	}
+	c.logger.Info().Any("page", pagePath).Any("libraries", len(detected)).Msg("Generated driver matrix")
+
+	statsPath := filepath.Join(docsRoot, proofStatsPath)
+	stats, err := updateProofDrivers(statsPath, detected, time.Now().UTC().Format(time.DateOnly))
+	if err != nil {
+		return err
+	}
+	if err := writeGeneratedPage(statsPath, stats); err != nil {
+		return fmt.Errorf("write %s: %w", proofStatsPath, err)
+	}
+	c.logger.Info().Any("data", statsPath).Any("libraries", len(detected)).Msg("Generated driver data")
+	return nil
+}
+
+// driverRepos narrows the registry to libraries that declare a driver contract.
+func driverRepos(repos []RepoConfig) []RepoConfig {
+	var filtered []RepoConfig
+	for _, repo := range repos {
+		if repo.Drivers != nil {
+			filtered = append(filtered, repo)
+		}
+	}
+	return filtered
+}
+
+// checkDriverLists compares drivers.md and the proof-stats.json drivers block against the drivers found in code.
+func checkDriverLists(docsRoot string, detected map[string][]libraryDriver) ([]string, error) {
+	docsDir := filepath.Base(docsRoot)
+	var problems []string
+
+	proofPath := filepath.Join(docsDir, proofStatsPath)
+	proofBytes, err := os.ReadFile(filepath.Join(docsRoot, proofStatsPath))
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", proofStatsPath, err)
+	}
+	var proof struct {
+		Drivers map[string][]string `json:"drivers"`
+	}
+	if err := json.Unmarshal(proofBytes, &proof); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", proofStatsPath, err)
+	}
+
+	pagePath := filepath.Join(docsDir, driversPagePath)
+	page, err := os.ReadFile(filepath.Join(docsRoot, driversPagePath))
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", driversPagePath, err)
+	}
+
+	proofDrift := 0
+	for _, slug := range sortedKeys(detected) {
+		drivers := detected[slug]
+		drift := describeDriverDrift(proofPath, slug, proof.Drivers[slug], drivers)
+		proofDrift += len(drift)
+		problems = append(problems, drift...)
+
+		block, _, _, err := driverTableBlock(string(page), slug)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %s: %v", pagePath, slug, err))
+			continue
+		}
+		names, _ := parseDriverTable(block)
+		problems = append(problems, describeDriverDrift(pagePath, slug, names, drivers)...)
+	}
+
+	// Matching names can still leave a stale total; the date argument is unused when nothing changes.
+	if proofDrift == 0 {
+		expected, err := updateProofDrivers(filepath.Join(docsRoot, proofStatsPath), detected, "")
+		if err != nil {
+			return nil, err
+		}
+		if expected != string(proofBytes) {
+			problems = append(problems, fmt.Sprintf("%s: totals.drivers does not match the drivers block", proofPath))
+		}
+	}
+	return problems, nil
+}
+
+func describeDriverDrift(file string, slug string, listed []string, drivers []libraryDriver) []string {
+	var problems []string
+	missing, extra := compareDriverNames(listed, drivers)
+	for _, name := range missing {
+		problems = append(problems, fmt.Sprintf("%s: %s: driver %q exists in code but is not listed", file, slug, name))
+	}
+	for _, name := range extra {
+		problems = append(problems, fmt.Sprintf("%s: %s: driver %q is listed but no driver package provides it", file, slug, name))
+	}
+	return problems
+}
@@ -0,0 +1,235 @@
+package docs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestDiscoverLibraryDriversFindsInterfaceImplementations verifies built-in types and driver modules are found without resolving external imports.
+func TestDiscoverLibraryDriversFindsInterfaceImplementations(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFiles(t, dir, map[string]string{
+		"go.mod": "module github.com/goforj/queue\n\ngo 1.23\n",
+		"queue.go": `package queue
+
+import "context"
+
+// Driver moves jobs between producers and workers.
+type Driver interface {
+	Push(ctx context.Context, job Job) error
+	Pop(ctx context.Context) (Job, error)
+}
+
+type Job struct{}
+
+type syncDriver struct{}
+
+func (syncDriver) Push(context.Context, Job) error       { return nil }
+func (syncDriver) Pop(context.Context) (Job, error)      { return Job{}, nil }
+
+type NullDriver struct{}
+
+func (*NullDriver) Push(context.Context, Job) error      { return nil }
+func (*NullDriver) Pop(context.Context) (Job, error)     { return Job{}, nil }
+`,
+		"driver/redisqueue/go.mod": "module github.com/goforj/queue/driver/redisqueue\n\ngo 1.23\n\nrequire github.com/redis/go-redis/v9 v9.0.0\n",
+		"driver/redisqueue/driver.go": `package redisqueue
+
+import (
+	"context"
+
+	"github.com/goforj/queue"
+	"github.com/redis/go-redis/v9"
+)
+
+type Driver struct{ client *redis.Client }
+
+func (d *Driver) Push(ctx context.Context, job queue.Job) error  { return d.client.Ping(ctx).Err() }
+func (d *Driver) Pop(ctx context.Context) (queue.Job, error)     { return queue.Job{}, nil }
+`,
+		"driver/halfqueue/driver.go":       "package halfqueue\n\nimport \"context\"\n\ntype Driver struct{}\n\nfunc (Driver) Push(context.Context, any) error { return nil }\n",
+		"driver/testkit/kit.go":            "package testkit\n\nimport \"context\"\n\ntype Fake struct{}\n\nfunc (Fake) Push(context.Context, any) error { return nil }\nfunc (Fake) Pop(context.Context) (any, error) { return nil, nil }\n",
+		"driver/redisqueue/driver_test.go": "package redisqueue\n\ntype testOnly struct{}\n",
+	})
+
+	repo := RepoConfig{Slug: "queue", Drivers: &DriverSpec{Type: "Driver", Suffix: "queue"}}
+	got, err := discoverLibraryDrivers(repo, dir)
+	if err != nil {
+		t.Fatalf("discoverLibraryDrivers() error = %v", err)
+	}
+	var names []string
+	for _, driver := range got {
+		names = append(names, driver.Name)
+	}
+	if strings.Join(names, ",") != "sync,null,redis" {
+		t.Fatalf("discoverLibraryDrivers() = %q, want sync,null,redis", names)
+	}
+	if got[2].Package != "github.com/goforj/queue/driver/redisqueue" || !got[0].BuiltIn {
+		t.Fatalf("discoverLibraryDrivers() = %+v, want built-ins and the driver module path", got)
+	}
+}
+
+// TestDiscoverLibraryDriversReadsStringConstants verifies string-typed contracts list built-ins from constants and modules by import.
+func TestDiscoverLibraryDriversReadsStringConstants(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFiles(t, dir, map[string]string{
+		"go.mod":                            "module github.com/goforj/events\n\ngo 1.23\n",
+		"events.go":                         "package events\n\nimport \"github.com/goforj/events/eventscore\"\n\nvar _ = eventscore.DriverSync\n",
+		"eventscore/driver.go":              "package eventscore\n\ntype Driver string\n\nconst (\n\tDriverSync Driver = \"sync\"\n\tDriverNull Driver = \"null\"\n)\n\nconst Version = \"v1\"\n",
+		"driver/natsjetstreamevents/go.mod": "module github.com/goforj/events/driver/natsjetstreamevents\n\ngo 1.23\n",
+		"driver/natsjetstreamevents/js.go":  "package natsjetstreamevents\n\nimport \"github.com/goforj/events/eventscore\"\n\nconst Name = eventscore.Driver(\"jetstream\")\n",
+		"driver/kafkaevents/kafka.go":       "package kafkaevents\n\nimport \"github.com/goforj/events/eventscore\"\n\nvar _ eventscore.Driver\n",
+		"driver/helpers/helpers.go":         "package helpers\n\nimport \"github.com/goforj/events/eventscore\"\n\nvar _ eventscore.Driver\n",
+		"driver/unusedevents/unused.go":     "package unusedevents\n",
+	})
+
+	repo := RepoConfig{Slug: "events", Drivers: &DriverSpec{
+		Package: "eventscore",
+		Type:    "Driver",
+		Suffix:  "events",
+		Aliases: map[string]string{"natsjetstream": "jetstream"},
+	}}
+	got, err := discoverLibraryDrivers(repo, dir)
+	if err != nil {
+		t.Fatalf("discoverLibraryDrivers() error = %v", err)
+	}
+	var names []string
+	for _, driver := range got {
+		names = append(names, driver.Name)
+	}
+	if strings.Join(names, ",") != "sync,null,jetstream,kafka" {
+		t.Fatalf("discoverLibraryDrivers() = %q, want sync,null,jetstream,kafka", names)
+	}
+}
+
+// TestUpdateDriversPageKeepsDescriptions verifies regeneration preserves prose, row order and descriptions.
+func TestUpdateDriversPageKeepsDescriptions(t *testing.T) {
+	t.Parallel()
+
+	page := strings.Join([]string{
+		"## Queue",
+		"",
+		"Intro prose.",
+		"",
+		"<!-- docs:drivers:queue -->",
+		"| Driver | What it is for |",
+		"| --- | --- |",
+		"| `sync` | Inline execution |",
+		"| `sqs` | AWS-native queue transport |",
+		"<!-- /docs:drivers:queue -->",
+		"",
+		"Details below.",
+	}, "\n")
+	drivers := []libraryDriver{
+		{Name: "sync", BuiltIn: true},
+		{Name: "kafka", Package: "github.com/goforj/queue/driver/kafkaqueue"},
+	}
+
+	got, err := updateDriversPage(page, "queue", drivers)
+	if err != nil {
+		t.Fatalf("updateDriversPage() error = %v", err)
+	}
+	want := strings.Join([]string{
+		"## Queue",
+		"",
+		"Intro prose.",
+		"",
+		"<!-- docs:drivers:queue -->",
+		"| Driver | What it is for |",
+		"| --- | --- |",
+		"| `sync` | Inline execution |",
+		"| `kafka` | Driver module `github.com/goforj/queue/driver/kafkaqueue` |",
+		"<!-- /docs:drivers:queue -->",
+		"",
+		"Details below.",
+	}, "\n")
+	if got != want {
+		t.Fatalf("updateDriversPage() =\n%s\nwant\n%s", got, want)
+	}
+
+	unchanged, err := updateDriversPage(want, "queue", drivers)
+	if err != nil || unchanged != want {
+		t.Fatalf("updateDriversPage() is not idempotent:\n%s", unchanged)
+	}
+
+	if _, err := updateDriversPage(page, "cache", drivers); err == nil {
+		t.Fatal("updateDriversPage() error = nil, want missing marker error")
+	}
+}
+
+// TestCompareDriverNamesReportsBothDirections verifies --check names drivers missing from the list and stale entries.
+func TestCompareDriverNamesReportsBothDirections(t *testing.T) {
+	t.Parallel()
+
+	missing, extra := compareDriverNames([]string{"sync", "sqs"}, []libraryDriver{{Name: "sync"}, {Name: "kafka"}})
+	if strings.Join(missing, ",") != "kafka" || strings.Join(extra, ",") != "sqs" {
+		t.Fatalf("compareDriverNames() = %q, %q; want kafka, sqs", missing, extra)
+	}
+}
+
+// TestUpdateProofDriversWritesDetectedDrivers verifies the proof-stats.json drivers block follows the code, keeps its
+// published order and totals, and that --check accepts the result but flags a stale total.
+func TestUpdateProofDriversWritesDetectedDrivers(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	proof := `{
+  "generatedAt": "2026-01-01",
+  "totals": {
+    "unitTests": 10,
+    "integrationTests": 2,
+    "testFunctions": 7,
+    "benchmarks": 1,
+    "drivers": 4,
+    "libraries": 1
+  },
+  "drivers": {
+    "queue": [
+      "sync",
+      "sqs"
+    ],
+    "events": [
+      "sync",
+      "nats"
+    ]
+  },
+  "repos": []
+}
+`
+	page := "<!-- docs:drivers:queue -->\n| Driver | What it is for |\n| --- | --- |\n| `sync` | Inline |\n| `kafka` | Kafka |\n<!-- /docs:drivers:queue -->\n"
+	writeTestFiles(t, root, map[string]string{proofStatsPath: proof, driversPagePath: page})
+	detected := map[string][]libraryDriver{"queue": {{Name: "kafka"}, {Name: "sync", BuiltIn: true}}}
+
+	statsPath := filepath.Join(root, proofStatsPath)
+	got, err := updateProofDrivers(statsPath, detected, "2026-10-18")
+	if err != nil {
+		t.Fatalf("updateProofDrivers() error = %v", err)
+	}
+	want := strings.NewReplacer(
+		`"generatedAt": "2026-01-01"`, `"generatedAt": "2026-10-18"`,
+		"\"sync\",\n      \"sqs\"", "\"sync\",\n      \"kafka\"",
+	).Replace(proof)
+	if got != want {
+		t.Fatalf("updateProofDrivers() =\n%s\nwant\n%s", got, want)
+	}
+
+	if err := os.WriteFile(statsPath, []byte(got), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if problems, err := checkDriverLists(root, detected); err != nil || len(problems) != 0 {
+		t.Fatalf("checkDriverLists() = %v, %v; want no problems", problems, err)
+	}
+	if err := os.WriteFile(statsPath, []byte(strings.Replace(got, `"drivers": 4`, `"drivers": 5`, 1)), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	problems, err := checkDriverLists(root, detected)
+	if err != nil || len(problems) != 1 || !strings.Contains(problems[0], "totals.drivers") {
+		t.Fatalf("checkDriverLists() = %v, %v; want a stale total reported", problems, err)
+	}
+}
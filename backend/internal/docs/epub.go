@@ -0,0 +1,446 @@
+package docs
+
+import (
+	"archive/zip"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	xhtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const epubTitle = "GoForj Documentation"
+
+// epubMediaTypes are the image formats EPUB 3 readers must support; anything else is left as its alt text.
+var epubMediaTypes = map[string]string{
+	".gif":  "image/gif",
+	".jpeg": "image/jpeg",
+	".jpg":  "image/jpeg",
+	".png":  "image/png",
+	".svg":  "image/svg+xml",
+	".webp": "image/webp",
+}
+
+// epubDroppedElements never make it into a chapter: scripts and interactive widgets do nothing in a reader.
+var epubDroppedElements = map[atom.Atom]bool{
+	atom.Script: true, atom.Style: true, atom.Iframe: true, atom.Button: true, atom.Input: true, atom.Select: true,
+	atom.Textarea: true, atom.Form: true, atom.Svg: true, atom.Video: true, atom.Audio: true, atom.Object: true,
+	atom.Embed: true, atom.Noscript: true, atom.Canvas: true, atom.Head: true, atom.Title: true, atom.Link: true,
+	atom.Meta: true,
+}
+
+// epubElements are kept as is. Unknown elements, which are mostly Vue components and <template> slots, are unwrapped
+// so their markdown content survives.
+var epubElements = map[atom.Atom]bool{
+	atom.A: true, atom.Abbr: true, atom.Aside: true, atom.B: true, atom.Blockquote: true, atom.Br: true,
+	atom.Caption: true, atom.Code: true, atom.Dd: true, atom.Del: true, atom.Details: true, atom.Div: true,
+	atom.Dl: true, atom.Dt: true, atom.Em: true, atom.Figcaption: true, atom.Figure: true, atom.H1: true,
+	atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true, atom.Hr: true, atom.I: true,
+	atom.Img: true, atom.Kbd: true, atom.Li: true, atom.Mark: true, atom.Ol: true, atom.P: true, atom.Pre: true,
+	atom.Q: true, atom.S: true, atom.Section: true, atom.Small: true, atom.Span: true, atom.Strong: true,
+	atom.Sub: true, atom.Summary: true, atom.Sup: true, atom.Table: true, atom.Tbody: true, atom.Td: true,
+	atom.Tfoot: true, atom.Th: true, atom.Thead: true, atom.Tr: true, atom.U: true, atom.Ul: true,
+}
+
+var epubAttributes = map[string]bool{
+	"alt": true, "class": true, "colspan": true, "href": true, "id": true, "rowspan": true, "src": true,
+	"start": true, "title": true,
+}
+
+var epubVoidElements = map[atom.Atom]bool{atom.Br: true, atom.Hr: true, atom.Img: true}
+
+const epubStylesheet = `body { font-family: serif; line-height: 1.5; }
+pre { white-space: pre-wrap; font-size: 0.85em; background: #f4f4f4; padding: 0.6em; }
+code { font-family: monospace; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 0.25em 0.5em; }
+img { max-width: 100%; }
+.custom-block { border-left: 4px solid #888; padding: 0 0.8em; margin: 1em 0; }
+.custom-block-title, .code-title { font-weight: bold; }
+`
+
+// epubBook is the offline edition: chapters in sidebar order plus the local images they embed.
+type epubBook struct {
+	Title      string
+	Identifier string
+	Modified   time.Time
+	Sections   []epubSection
+	Chapters   []*epubChapter
+	Images     []*epubImage
+	// RemoteImages counts images left as alt text because the reader cannot fetch them offline.
+	RemoteImages int
+	// Skipped lists sidebar links with no markdown page behind them.
+	Skipped []string
+}
+
+// epubSection is one sidebar group in the table of contents.
+type epubSection struct {
+	Title    string
+	Chapters []*epubChapter
+}
+
+// epubChapter is one page rendered to a standalone XHTML document.
+type epubChapter struct {
+	File  string
+	Title string
+	Page  *docsPage
+	Body  string
+}
+
+// epubImage is a local asset copied into the book.
+type epubImage struct {
+	ID        string
+	File      string
+	MediaType string
+	Source    string
+}
+
+// epubOptions carry what the book cannot learn from the markdown tree.
+type epubOptions struct {
+	SiteURL  string
+	Release  string
+	Modified time.Time
+}
+
+// epubBuilder resolves links and images for every chapter of one book.
+type epubBuilder struct {
+	site     docsSite
+	options  epubOptions
+	book     *epubBook
+	chapters map[string]*epubChapter
+	images   map[string]*epubImage
+}
+
+// buildEPUB renders each sidebar page once, in sidebar order. Links to pages in the book point at their chapter,
+// links to other pages point at the published site, and local images are embedded.
+func buildEPUB(site docsSite, sections []sidebarSection, options epubOptions) (epubBook, error) {
+	identifier := "urn:goforj-docs:main"
+	if options.Release != "" {
+		identifier = "urn:goforj-docs:" + options.Release
+	}
+	book := epubBook{Title: epubTitle, Identifier: identifier, Modified: options.Modified.UTC()}
+	builder := &epubBuilder{
+		site:     site,
+		options:  options,
+		book:     &book,
+		chapters: map[string]*epubChapter{},
+		images:   map[string]*epubImage{},
+	}
+
+	for _, section := range sections {
+		group := epubSection{Title: section.Title}
+		for _, item := range section.Items {
+			route := normalizeRoute(item.Route)
+			page, ok := site.Pages[route]
+			if !ok {
+				book.Skipped = append(book.Skipped, fmt.Sprintf("%s > %s links to %s, which is not a page", section.Title, item.Text, item.Route))
+				continue
+			}
+			if _, seen := builder.chapters[route]; seen {
+				continue
+			}
+			chapter := &epubChapter{File: fmt.Sprintf("chapter-%03d.xhtml", len(book.Chapters)+1), Title: item.Text, Page: page}
+			builder.chapters[route] = chapter
+			book.Chapters = append(book.Chapters, chapter)
+			group.Chapters = append(group.Chapters, chapter)
+		}
+		if len(group.Chapters) > 0 {
+			book.Sections = append(book.Sections, group)
+		}
+	}
+
+	for _, chapter := range book.Chapters {
+		content, err := os.ReadFile(filepath.Join(site.Root, filepath.FromSlash(chapter.Page.File)))
+		if err != nil {
+			return book, fmt.Errorf("read %s: %w", chapter.Page.File, err)
+		}
+		body := frontmatterBlockRegex.ReplaceAllString(expandReleaseTokens(string(content), options.Release), "")
+		chapter.Body, err = builder.renderXHTML(chapter.Page, renderMarkdownHTML(body))
+		if err != nil {
+			return book, fmt.Errorf("render %s: %w", chapter.Page.File, err)
+		}
+	}
+	return book, nil
+}
+
+// expandReleaseTokens mirrors the VitePress markdown transform for the release label.
+func expandReleaseTokens(content string, release string) string {
+	if release == "" {
+		return content
+	}
+	content = strings.ReplaceAll(content, "%%LATEST_RELEASE_ANCHOR%%", releaseAnchor(release))
+	return strings.ReplaceAll(content, "%%LATEST_RELEASE%%", release)
+}
+
+// renderXHTML parses loose HTML the way a browser would and writes it back as well-formed XHTML, which EPUB readers
+// require, dropping interactive elements and rewriting every href and src for the book.
+func (b *epubBuilder) renderXHTML(page *docsPage, fragment string) (string, error) {
+	context := &xhtml.Node{Type: xhtml.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := xhtml.ParseFragment(strings.NewReader(fragment), context)
+	if err != nil {
+		return "", err
+	}
+	var out strings.Builder
+	for _, node := range nodes {
+		b.writeNode(&out, page, node)
+	}
+	return out.String(), nil
+}
+
+func (b *epubBuilder) writeNode(out *strings.Builder, page *docsPage, node *xhtml.Node) {
+	switch node.Type {
+	case xhtml.TextNode:
+		out.WriteString(html.EscapeString(node.Data))
+		return
+	case xhtml.ElementNode:
+	default:
+		return
+	}
+	if epubDroppedElements[node.DataAtom] {
+		return
+	}
+	if !epubElements[node.DataAtom] {
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			b.writeNode(out, page, child)
+		}
+		return
+	}
+
+	var attrs strings.Builder
+	alt := ""
+	for _, attr := range node.Attr {
+		if attr.Namespace != "" || !epubAttributes[attr.Key] {
+			continue
+		}
+		value := attr.Val
+		switch attr.Key {
+		case "alt":
+			alt = value
+			continue
+		case "href":
+			value = b.resolveLink(page, value)
+		case "src":
+			resolved, ok := b.resolveImage(page, value)
+			if !ok {
+				out.WriteString(html.EscapeString(imageAltText(node)))
+				return
+			}
+			value = resolved
+		}
+		fmt.Fprintf(&attrs, " %s=\"%s\"", attr.Key, html.EscapeString(value))
+	}
+	if node.DataAtom == atom.Img {
+		if !strings.Contains(attrs.String(), " src=") {
+			return
+		}
+		fmt.Fprintf(&attrs, " alt=\"%s\"", html.EscapeString(alt))
+	}
+
+	if epubVoidElements[node.DataAtom] {
+		fmt.Fprintf(out, "<%s%s/>", node.Data, attrs.String())
+		return
+	}
+	fmt.Fprintf(out, "<%s%s>", node.Data, attrs.String())
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		b.writeNode(out, page, child)
+	}
+	fmt.Fprintf(out, "</%s>", node.Data)
+}
+
+func imageAltText(node *xhtml.Node) string {
+	for _, attr := range node.Attr {
+		if attr.Key == "alt" {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+// resolveLink follows checkLink's resolution: pages in the book become chapter links, other pages and assets go to
+// the published site, and external or in-page links are unchanged.
+func (b *epubBuilder) resolveLink(page *docsPage, target string) string {
+	lower := strings.ToLower(target)
+	if target == "" || strings.HasPrefix(target, "#") || strings.Contains(target, "://") || strings.HasPrefix(target, "//") ||
+		strings.HasPrefix(lower, "mailto:") || strings.HasPrefix(lower, "tel:") || strings.Contains(target, "{{") {
+		return target
+	}
+	pathPart, fragment, hasFragment := strings.Cut(target, "#")
+	if cut := strings.Index(pathPart, "?"); cut >= 0 {
+		pathPart = pathPart[:cut]
+	}
+	route := pathPart
+	if !strings.HasPrefix(route, "/") {
+		route = path.Join(b.site.servedDir(page), route)
+	}
+	suffix := ""
+	if hasFragment {
+		suffix = "#" + fragment
+	}
+	if ext := path.Ext(pathPart); ext != "" && ext != ".md" && ext != ".html" {
+		return strings.TrimRight(b.options.SiteURL, "/") + path.Clean(route) + suffix
+	}
+	route = normalizeRoute(route)
+	if chapter, ok := b.chapters[route]; ok {
+		return chapter.File + suffix
+	}
+	return strings.TrimRight(b.options.SiteURL, "/") + route + suffix
+}
+
+// resolveImage embeds local images the way assetExists finds them. Remote images are counted and dropped because a
+// reader offline could not load them anyway.
+func (b *epubBuilder) resolveImage(page *docsPage, src string) (string, bool) {
+	lower := strings.ToLower(src)
+	if strings.HasPrefix(lower, "data:") {
+		return src, true
+	}
+	if strings.Contains(src, "://") || strings.HasPrefix(src, "//") {
+		b.book.RemoteImages++
+		return "", false
+	}
+	src, _, _ = strings.Cut(src, "?")
+	src, _, _ = strings.Cut(src, "#")
+	mediaType, ok := epubMediaTypes[strings.ToLower(path.Ext(src))]
+	if !ok {
+		return "", false
+	}
+	var candidates []string
+	if strings.HasPrefix(src, "/") {
+		candidates = []string{path.Join("public", src), path.Clean(src[1:])}
+	} else {
+		candidates = []string{path.Join(path.Dir(page.File), src)}
+	}
+	for _, candidate := range candidates {
+		if image, ok := b.images[candidate]; ok {
+			return image.File, true
+		}
+		source := filepath.Join(b.site.Root, filepath.FromSlash(candidate))
+		if info, err := os.Stat(source); err != nil || info.IsDir() {
+			continue
+		}
+		image := &epubImage{
+			ID:        fmt.Sprintf("image-%03d", len(b.book.Images)+1),
+			File:      path.Join("images", strings.TrimPrefix(candidate, "public/")),
+			MediaType: mediaType,
+			Source:    source,
+		}
+		b.images[candidate] = image
+		b.book.Images = append(b.book.Images, image)
+		return image.File, true
+	}
+	return "", false
+}
+
+// writeEPUB packages the book. The mimetype entry must come first and stay uncompressed so readers can sniff it.
+func writeEPUB(w io.Writer, book epubBook) error {
+	archive := zip.NewWriter(w)
+	write := func(name string, method uint16, content []byte) error {
+		entry, err := archive.CreateHeader(&zip.FileHeader{Name: name, Method: method, Modified: book.Modified})
+		if err != nil {
+			return err
+		}
+		_, err = entry.Write(content)
+		return err
+	}
+
+	if err := write("mimetype", zip.Store, []byte("application/epub+zip")); err != nil {
+		return err
+	}
+	if err := write("META-INF/container.xml", zip.Deflate, []byte(epubContainer)); err != nil {
+		return err
+	}
+	if err := write("OEBPS/content.opf", zip.Deflate, []byte(renderEPUBPackage(book))); err != nil {
+		return err
+	}
+	if err := write("OEBPS/nav.xhtml", zip.Deflate, []byte(renderEPUBNav(book))); err != nil {
+		return err
+	}
+	if err := write("OEBPS/style.css", zip.Deflate, []byte(epubStylesheet)); err != nil {
+		return err
+	}
+	for _, chapter := range book.Chapters {
+		if err := write("OEBPS/"+chapter.File, zip.Deflate, []byte(xhtmlDocument(chapter.Title, chapter.Body))); err != nil {
+			return err
+		}
+	}
+	for _, image := range book.Images {
+		content, err := os.ReadFile(image.Source)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", image.Source, err)
+		}
+		if err := write("OEBPS/"+image.File, zip.Deflate, content); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+const epubContainer = `<?xml version="1.0" encoding="UTF-8"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+  <rootfiles>
+    <rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/>
+  </rootfiles>
+</container>
+`
+
+// renderEPUBPackage writes the OPF manifest and spine. The navigation document leads the spine so readers open on
+// the table of contents.
+func renderEPUBPackage(book epubBook) string {
+	var out strings.Builder
+	out.WriteString("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n")
+	out.WriteString("<package xmlns=\"http://www.idpf.org/2007/opf\" version=\"3.0\" unique-identifier=\"book-id\" xml:lang=\"en\">\n")
+	out.WriteString("  <metadata xmlns:dc=\"http://purl.org/dc/elements/1.1/\">\n")
+	fmt.Fprintf(&out, "    <dc:identifier id=\"book-id\">%s</dc:identifier>\n", html.EscapeString(book.Identifier))
+	fmt.Fprintf(&out, "    <dc:title>%s</dc:title>\n", html.EscapeString(book.Title))
+	out.WriteString("    <dc:language>en</dc:language>\n")
+	fmt.Fprintf(&out, "    <meta property=\"dcterms:modified\">%s</meta>\n", book.Modified.Format("2006-01-02T15:04:05Z"))
+	out.WriteString("  </metadata>\n  <manifest>\n")
+	out.WriteString("    <item id=\"nav\" href=\"nav.xhtml\" media-type=\"application/xhtml+xml\" properties=\"nav\"/>\n")
+	out.WriteString("    <item id=\"style\" href=\"style.css\" media-type=\"text/css\"/>\n")
+	for i, chapter := range book.Chapters {
+		fmt.Fprintf(&out, "    <item id=\"chapter-%03d\" href=\"%s\" media-type=\"application/xhtml+xml\"/>\n", i+1, chapter.File)
+	}
+	for _, image := range book.Images {
+		fmt.Fprintf(&out, "    <item id=\"%s\" href=\"%s\" media-type=\"%s\"/>\n", image.ID, html.EscapeString(image.File), image.MediaType)
+	}
+	out.WriteString("  </manifest>\n  <spine>\n    <itemref idref=\"nav\"/>\n")
+	for i := range book.Chapters {
+		fmt.Fprintf(&out, "    <itemref idref=\"chapter-%03d\"/>\n", i+1)
+	}
+	out.WriteString("  </spine>\n</package>\n")
+	return out.String()
+}
+
+// renderEPUBNav writes the table of contents, one list per sidebar group.
+func renderEPUBNav(book epubBook) string {
+	var body strings.Builder
+	body.WriteString("<nav epub:type=\"toc\" id=\"toc\">\n<h1>Contents</h1>\n<ol>\n")
+	for _, section := range book.Sections {
+		fmt.Fprintf(&body, "<li><span>%s</span>\n<ol>\n", html.EscapeString(section.Title))
+		for _, chapter := range section.Chapters {
+			fmt.Fprintf(&body, "<li><a href=\"%s\">%s</a></li>\n", chapter.File, html.EscapeString(chapter.Title))
+		}
+		body.WriteString("</ol>\n</li>\n")
+	}
+	body.WriteString("</ol>\n</nav>\n")
+	return xhtmlDocument("Contents", body.String())
+}
+
+func xhtmlDocument(title string, body string) string {
+	return fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" xml:lang="en" lang="en">
+<head>
+<title>%s</title>
+<link rel="stylesheet" type="text/css" href="style.css"/>
+</head>
+<body>
+%s</body>
+</html>
+`, html.EscapeString(title), body)
+}
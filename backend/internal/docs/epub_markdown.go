@@ -0,0 +1,416 @@
+package docs
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+var fenceOpenRegex = regexp.MustCompile("^(\\s*)(`{3,}|~{3,})\\s*([^\\s`{\\[]*)[^`\\[]*(?:\\[([^\\]]*)\\])?")
+var containerOpenRegex = regexp.MustCompile(`^\s*(:{3,})\s*([A-Za-z][\w-]*)\s*(.*?)\s*$`)
+var containerCloseRegex = regexp.MustCompile(`^\s*:{3,}\s*$`)
+var thematicBreakRegex = regexp.MustCompile(`^\s{0,3}(?:(?:-\s*){3,}|(?:\*\s*){3,}|(?:_\s*){3,})$`)
+var htmlBlockStartRegex = regexp.MustCompile(`^\s{0,3}<(?:/?[A-Za-z][\w-]*[\s/>]|/?[A-Za-z][\w-]*$|!--)`)
+var blockquoteRegex = regexp.MustCompile(`^\s{0,3}> ?(.*)$`)
+var listItemRegex = regexp.MustCompile(`^(\s*)([-*+]|\d{1,9}[.)])(\s+|$)(.*)$`)
+var tableDelimiterRegex = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?\s*$`)
+var inlineLinkRegex = regexp.MustCompile(`(!?)\[((?:[^\[\]]|\[[^\]]*\])*)\]\(\s*<?([^()\s<>]*(?:\([^()\s]*\)[^()\s<>]*)*)>?(?:\s+(?:"([^"]*)"|'([^']*)'))?\s*\)`)
+var autolinkRegex = regexp.MustCompile(`<((?:https?|mailto):[^\s<>]+)>`)
+var markdownEscapeRegex = regexp.MustCompile("\\\\([\\\\`*_{}\\[\\]()#+\\-.!|<>~])")
+var strongRegex = regexp.MustCompile(`\*\*([^*\s](?:[^*]*[^*\s])?)\*\*|__([^_\s](?:[^_]*[^_\s])?)__`)
+var emphasisRegex = regexp.MustCompile(`\*([^*\s](?:[^*]*[^*\s])?)\*|\b_([^_\s](?:[^_]*[^_\s])?)_\b`)
+var strikethroughRegex = regexp.MustCompile(`~~([^~]+)~~`)
+
+// customBlockTitles are the labels VitePress prints on ::: containers that carry no title of their own.
+var customBlockTitles = map[string]string{
+	"tip":     "TIP",
+	"info":    "INFO",
+	"warning": "WARNING",
+	"danger":  "DANGER",
+	"details": "Details",
+}
+
+// markdownRenderer turns one page of VitePress markdown into loose HTML. It covers the constructs the docs use;
+// inline HTML and Vue components pass through for renderXHTML to clean up.
+type markdownRenderer struct {
+	anchors map[string]struct{}
+}
+
+// renderMarkdownHTML renders a page body without frontmatter. Heading IDs follow pageAnchors so fragments that
+// resolve on the site resolve in the book.
+func renderMarkdownHTML(content string) string {
+	renderer := &markdownRenderer{anchors: map[string]struct{}{}}
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	return renderer.blocks(strings.Split(content, "\n"))
+}
+
+func (r *markdownRenderer) blocks(lines []string) string {
+	var out strings.Builder
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			i++
+		case fenceOpenRegex.MatchString(line):
+			i = r.fence(lines, i, &out)
+		case containerOpenRegex.MatchString(line):
+			i = r.container(lines, i, &out)
+		case markdownHeadingRegex.MatchString(trimmed):
+			r.heading(trimmed, &out)
+			i++
+		case thematicBreakRegex.MatchString(line):
+			out.WriteString("<hr/>\n")
+			i++
+		case htmlBlockStartRegex.MatchString(line):
+			i = r.htmlBlock(lines, i, &out)
+		case blockquoteRegex.MatchString(line):
+			i = r.blockquote(lines, i, &out)
+		case listItemRegex.MatchString(line) && !thematicBreakRegex.MatchString(line):
+			i = r.list(lines, i, &out)
+		case i+1 < len(lines) && strings.Contains(line, "|") && tableDelimiterRegex.MatchString(lines[i+1]) && strings.Contains(lines[i+1], "-"):
+			i = r.table(lines, i, &out)
+		default:
+			i = r.paragraph(lines, i, &out)
+		}
+	}
+	return out.String()
+}
+
+// fence renders a code block. A ```go [main.go] label becomes a caption, as it does on code-group tabs.
+func (r *markdownRenderer) fence(lines []string, start int, out *strings.Builder) int {
+	open := fenceOpenRegex.FindStringSubmatch(lines[start])
+	indent, marker, language, label := len(open[1]), open[2], open[3], open[4]
+	var body []string
+	i := start + 1
+	for ; i < len(lines); i++ {
+		closing := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(closing, marker) && strings.Trim(closing, marker[:1]) == "" {
+			i++
+			break
+		}
+		body = append(body, trimIndent(lines[i], indent))
+	}
+	if label != "" {
+		fmt.Fprintf(out, "<p class=\"code-title\">%s</p>\n", html.EscapeString(label))
+	}
+	if language != "" {
+		fmt.Fprintf(out, "<pre><code class=\"language-%s\">", html.EscapeString(language))
+	} else {
+		out.WriteString("<pre><code>")
+	}
+	out.WriteString(html.EscapeString(strings.Join(body, "\n")))
+	out.WriteString("</code></pre>\n")
+	return i
+}
+
+// container renders ::: blocks, counting nested openers so an inner ::: closes the right block.
+func (r *markdownRenderer) container(lines []string, start int, out *strings.Builder) int {
+	open := containerOpenRegex.FindStringSubmatch(lines[start])
+	kind, title := strings.ToLower(open[2]), open[3]
+	depth := 1
+	inCode := false
+	i := start + 1
+	bodyStart := i
+	for ; i < len(lines); i++ {
+		if strings.HasPrefix(strings.TrimSpace(lines[i]), "```") {
+			inCode = !inCode
+		}
+		if inCode {
+			continue
+		}
+		if containerOpenRegex.MatchString(lines[i]) {
+			depth++
+		} else if containerCloseRegex.MatchString(lines[i]) {
+			depth--
+			if depth == 0 {
+				break
+			}
+		}
+	}
+	body := r.blocks(lines[bodyStart:min(i, len(lines))])
+	if fallback, ok := customBlockTitles[kind]; ok {
+		if title == "" {
+			title = fallback
+		}
+		fmt.Fprintf(out, "<aside class=\"custom-block %s\">\n<p class=\"custom-block-title\">%s</p>\n%s</aside>\n", kind, renderInline(title), body)
+	} else {
+		fmt.Fprintf(out, "<div class=\"%s\">\n%s</div>\n", html.EscapeString(kind), body)
+	}
+	return i + 1
+}
+
+func (r *markdownRenderer) heading(line string, out *strings.Builder) {
+	matches := markdownHeadingRegex.FindStringSubmatch(line)
+	level := len(matches[1])
+	title := strings.TrimSpace(strings.TrimRight(matches[2], "#"))
+	if explicit := headingWithIDRegex.FindStringSubmatch(line); len(explicit) == 4 {
+		title = explicit[2]
+	}
+	if id := headingAnchor(line, r.anchors); id != "" {
+		fmt.Fprintf(out, "<h%d id=\"%s\">%s</h%d>\n", level, html.EscapeString(id), renderInline(title), level)
+		return
+	}
+	fmt.Fprintf(out, "<h%d>%s</h%d>\n", level, renderInline(title), level)
+}
+
+// htmlBlock passes raw HTML through up to the next blank line, like markdown-it's HTML blocks. Comments end at their
+// closing marker instead, so an annotation directly above a code fence leaves the fence alone.
+func (r *markdownRenderer) htmlBlock(lines []string, start int, out *strings.Builder) int {
+	if strings.HasPrefix(strings.TrimSpace(lines[start]), "<!--") {
+		i := start
+		for i < len(lines) && !strings.Contains(lines[i], "-->") {
+			i++
+		}
+		return min(i+1, len(lines))
+	}
+	i := start
+	for ; i < len(lines) && strings.TrimSpace(lines[i]) != ""; i++ {
+		for _, match := range htmlIDAttrRegex.FindAllStringSubmatch(lines[i], -1) {
+			r.anchors[match[1]] = struct{}{}
+		}
+		out.WriteString(lines[i])
+		out.WriteString("\n")
+	}
+	return i
+}
+
+func (r *markdownRenderer) blockquote(lines []string, start int, out *strings.Builder) int {
+	var body []string
+	i := start
+	for ; i < len(lines); i++ {
+		matches := blockquoteRegex.FindStringSubmatch(lines[i])
+		if matches == nil {
+			break
+		}
+		body = append(body, matches[1])
+	}
+	fmt.Fprintf(out, "<blockquote>\n%s</blockquote>\n", r.blocks(body))
+	return i
+}
+
+// list renders one list. An item owns the lines indented past its marker; a blank line inside an item makes the
+// list loose, which keeps item paragraphs wrapped in <p>.
+func (r *markdownRenderer) list(lines []string, start int, out *strings.Builder) int {
+	first := listItemRegex.FindStringSubmatch(lines[start])
+	indent := len(first[1])
+	ordered := first[2][0] >= '0' && first[2][0] <= '9'
+	if ordered {
+		number := strings.TrimRight(first[2], ".)")
+		if number != "1" {
+			fmt.Fprintf(out, "<ol start=\"%s\">\n", strings.TrimLeft(number, "0"))
+		} else {
+			out.WriteString("<ol>\n")
+		}
+	} else {
+		out.WriteString("<ul>\n")
+	}
+
+	i := start
+	for i < len(lines) {
+		item := listItemRegex.FindStringSubmatch(lines[i])
+		if item == nil || len(item[1]) != indent || (item[2][0] >= '0' && item[2][0] <= '9') != ordered {
+			break
+		}
+		contentIndent := len(item[1]) + len(item[2]) + max(len(item[3]), 1)
+		body := []string{item[4]}
+		loose := false
+		i++
+		for i < len(lines) {
+			line := lines[i]
+			if strings.TrimSpace(line) == "" {
+				next := i + 1
+				for next < len(lines) && strings.TrimSpace(lines[next]) == "" {
+					next++
+				}
+				if next >= len(lines) || leadingSpaces(lines[next]) < contentIndent {
+					break
+				}
+				loose = true
+				body = append(body, "")
+				i++
+				continue
+			}
+			if leadingSpaces(line) >= contentIndent {
+				body = append(body, trimIndent(line, contentIndent))
+				i++
+				continue
+			}
+			if listItemRegex.MatchString(line) || !continuesParagraph(line) {
+				break
+			}
+			body = append(body, strings.TrimSpace(line))
+			i++
+		}
+		rendered := strings.TrimSuffix(r.blocks(body), "\n")
+		if !loose && strings.HasPrefix(rendered, "<p>") {
+			end := strings.Index(rendered, "</p>")
+			rendered = rendered[len("<p>"):end] + rendered[end+len("</p>"):]
+		}
+		fmt.Fprintf(out, "<li>%s</li>\n", rendered)
+
+		blank := i
+		for blank < len(lines) && strings.TrimSpace(lines[blank]) == "" {
+			blank++
+		}
+		if blank < len(lines) && blank > i {
+			if next := listItemRegex.FindStringSubmatch(lines[blank]); next != nil && len(next[1]) == indent {
+				i = blank
+			}
+		}
+	}
+
+	if ordered {
+		out.WriteString("</ol>\n")
+	} else {
+		out.WriteString("</ul>\n")
+	}
+	return i
+}
+
+func (r *markdownRenderer) table(lines []string, start int, out *strings.Builder) int {
+	out.WriteString("<table>\n<thead>\n<tr>")
+	for _, cell := range tableCells(lines[start]) {
+		fmt.Fprintf(out, "<th>%s</th>", renderInline(cell))
+	}
+	out.WriteString("</tr>\n</thead>\n<tbody>\n")
+	i := start + 2
+	for ; i < len(lines) && strings.TrimSpace(lines[i]) != "" && strings.Contains(lines[i], "|"); i++ {
+		out.WriteString("<tr>")
+		for _, cell := range tableCells(lines[i]) {
+			fmt.Fprintf(out, "<td>%s</td>", renderInline(cell))
+		}
+		out.WriteString("</tr>\n")
+	}
+	out.WriteString("</tbody>\n</table>\n")
+	return i
+}
+
+func (r *markdownRenderer) paragraph(lines []string, start int, out *strings.Builder) int {
+	var text []string
+	i := start
+	for ; i < len(lines); i++ {
+		if i > start && !continuesParagraph(lines[i]) {
+			break
+		}
+		text = append(text, strings.TrimSpace(lines[i]))
+	}
+	fmt.Fprintf(out, "<p>%s</p>\n", renderInline(strings.Join(text, "\n")))
+	return i
+}
+
+// continuesParagraph reports whether a line is paragraph text rather than the start of another block.
+func continuesParagraph(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return trimmed != "" &&
+		!fenceOpenRegex.MatchString(line) &&
+		!containerOpenRegex.MatchString(line) &&
+		!containerCloseRegex.MatchString(line) &&
+		!markdownHeadingRegex.MatchString(trimmed) &&
+		!thematicBreakRegex.MatchString(line) &&
+		!blockquoteRegex.MatchString(line) &&
+		!listItemRegex.MatchString(line)
+}
+
+// tableCells splits a table row on pipes outside inline code, honouring \| escapes.
+func tableCells(row string) []string {
+	row = strings.TrimSpace(row)
+	row = strings.TrimPrefix(row, "|")
+	if strings.HasSuffix(row, "|") && !strings.HasSuffix(row, "\\|") {
+		row = row[:len(row)-1]
+	}
+	var cells []string
+	var cell strings.Builder
+	inCode := false
+	for i := 0; i < len(row); i++ {
+		switch {
+		case row[i] == '\\' && i+1 < len(row) && row[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+		case row[i] == '`':
+			inCode = !inCode
+			cell.WriteByte('`')
+		case row[i] == '|' && !inCode:
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(row[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+// renderInline handles code spans, links, images, autolinks and emphasis. Code and link markup are parked behind
+// placeholders first so emphasis markers inside them are left alone.
+func renderInline(text string) string {
+	var parked []string
+	text = parkInline(text, &parked)
+	for i := len(parked) - 1; i >= 0; i-- {
+		text = strings.ReplaceAll(text, fmt.Sprintf("\x00%d\x00", i), parked[i])
+	}
+	return text
+}
+
+// parkInline renders one run of inline markdown. Link text is rendered recursively into the same placeholder list,
+// so restoring in reverse order unwinds nested markup.
+func parkInline(text string, parked *[]string) string {
+	park := func(markup string) string {
+		*parked = append(*parked, markup)
+		return fmt.Sprintf("\x00%d\x00", len(*parked)-1)
+	}
+
+	var out strings.Builder
+	for len(text) > 0 {
+		open := strings.Index(text, "`")
+		if open < 0 {
+			out.WriteString(text)
+			break
+		}
+		run := len(text[open:]) - len(strings.TrimLeft(text[open:], "`"))
+		closing := strings.Index(text[open+run:], text[open:open+run])
+		if closing < 0 {
+			out.WriteString(text[:open+run])
+			text = text[open+run:]
+			continue
+		}
+		out.WriteString(text[:open])
+		code := strings.TrimSpace(text[open+run : open+run+closing])
+		out.WriteString(park("<code>" + html.EscapeString(code) + "</code>"))
+		text = text[open+run+closing+run:]
+	}
+	text = out.String()
+
+	text = markdownEscapeRegex.ReplaceAllStringFunc(text, func(escaped string) string {
+		return park(html.EscapeString(escaped[1:]))
+	})
+	text = inlineLinkRegex.ReplaceAllStringFunc(text, func(link string) string {
+		parts := inlineLinkRegex.FindStringSubmatch(link)
+		title := parts[4] + parts[5]
+		titleAttr := ""
+		if title != "" {
+			titleAttr = fmt.Sprintf(" title=\"%s\"", html.EscapeString(title))
+		}
+		if parts[1] == "!" {
+			return park(fmt.Sprintf("<img src=\"%s\" alt=\"%s\"%s/>", html.EscapeString(parts[3]), html.EscapeString(headingText(parts[2])), titleAttr))
+		}
+		return park(fmt.Sprintf("<a href=\"%s\"%s>%s</a>", html.EscapeString(parts[3]), titleAttr, parkInline(parts[2], parked)))
+	})
+	text = autolinkRegex.ReplaceAllStringFunc(text, func(link string) string {
+		target := html.EscapeString(strings.Trim(link, "<>"))
+		return park(fmt.Sprintf("<a href=\"%s\">%s</a>", target, target))
+	})
+	text = strongRegex.ReplaceAllString(text, "<strong>$1$2</strong>")
+	text = emphasisRegex.ReplaceAllString(text, "<em>$1$2</em>")
+	text = strikethroughRegex.ReplaceAllString(text, "<del>$1</del>")
+	return strings.ReplaceAll(text, "  \n", "<br/>\n")
+}
+
+func leadingSpaces(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " \t"))
+}
+
+// trimIndent removes up to n leading spaces so nested blocks render from column zero.
+func trimIndent(line string, n int) string {
+	return line[min(n, leadingSpaces(line)):]
+}
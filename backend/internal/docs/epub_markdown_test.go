@@ -0,0 +1,71 @@
+package docs
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestRenderMarkdownHTMLCoversDocsConstructs verifies the block and inline constructs the docs rely on.
+func TestRenderMarkdownHTMLCoversDocsConstructs(t *testing.T) {
+	t.Parallel()
+
+	content := strings.Join([]string{
+		"# Title",
+		"",
+		"## Setup",
+		"",
+		"## Setup",
+		"",
+		"### `make:job` {#make-job}",
+		"",
+		"Use **bold**, *em*, `a_b*c`, snake_case and [`code` link](/x \"hint\").",
+		"",
+		"<!-- go-example: illustrative-fragment -->",
+		"```go [main.go]",
+		"if a < b {",
+		"",
+		"}",
+		"```",
+		"",
+		"::: warning Port 3000",
+		"Stop the other app.",
+		":::",
+		"",
+		"- one",
+		"- two",
+		"  - nested",
+		"",
+		"3. third",
+		"",
+		"| Name | Value |",
+		"| --- | --- |",
+		"| `a\\|b` | [c](/c) |",
+		"",
+		"> quoted",
+		"",
+		"---",
+	}, "\n")
+	rendered := renderMarkdownHTML(content)
+	for _, want := range []string{
+		`<h1 id="title">Title</h1>`,
+		`<h2 id="setup">Setup</h2>`,
+		`<h2 id="setup-1">Setup</h2>`,
+		`<h3 id="make-job"><code>make:job</code></h3>`,
+		`<strong>bold</strong>, <em>em</em>, <code>a_b*c</code>, snake_case and <a href="/x" title="hint"><code>code</code> link</a>.`,
+		"<p class=\"code-title\">main.go</p>\n<pre><code class=\"language-go\">if a &lt; b {\n\n}</code></pre>",
+		"<aside class=\"custom-block warning\">\n<p class=\"custom-block-title\">Port 3000</p>\n<p>Stop the other app.</p>\n</aside>",
+		"<ul>\n<li>one</li>\n<li>two\n<ul>\n<li>nested</li>\n</ul></li>\n</ul>",
+		`<ol start="3">`,
+		"<th>Name</th><th>Value</th>",
+		`<td><code>a|b</code></td><td><a href="/c">c</a></td>`,
+		"<blockquote>\n<p>quoted</p>\n</blockquote>",
+		"<hr/>",
+	} {
+		if !strings.Contains(rendered, want) {
+			t.Fatalf("renderMarkdownHTML() =\n%s\nwant %q", rendered, want)
+		}
+	}
+	if strings.Contains(rendered, "go-example") {
+		t.Fatalf("renderMarkdownHTML() =\n%s\nwant the HTML comment dropped", rendered)
+	}
+}
@@ -0,0 +1,113 @@
+package docs
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestBuildEPUBResolvesLinksAndImages verifies chapters follow the sidebar, links to pages in the book become chapter
+// links, other pages link to the site and only local images are embedded.
+func TestBuildEPUBResolvesLinksAndImages(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	writeTestFiles(t, root, map[string]string{
+		"guide/index.md":         "---\ntitle: Guide\n---\n\n# Guide\n\nRead [setup](./setup#install-it), [the blog](/blog/post) and [the FAQ](/guide/faq).\n\n![diagram](/assets/flow.png) ![badge](https://img.shields.io/badge/x.svg)\n\n<p align=\"center\"><img src=\"/assets/flow.png\" alt=\"again\"><br><MyWidget :prop=\"1\">kept</MyWidget></p>\n",
+		"guide/setup.md":         "# Setup\n\n## Install it\n\nSee %%LATEST_RELEASE%%.\n",
+		"blog/post.md":           "# Post\n",
+		"public/assets/flow.png": "png",
+	})
+	site, err := loadDocsSite(root, nil)
+	if err != nil {
+		t.Fatalf("loadDocsSite() error = %v", err)
+	}
+	sections := []sidebarSection{
+		{Title: "Guide", Items: []sidebarItem{{Text: "Setup", Route: "/guide/setup"}, {Text: "Overview", Route: "/guide/"}, {Text: "FAQ", Route: "/guide/faq"}}},
+		{Title: "Empty", Items: []sidebarItem{{Text: "Again", Route: "/guide/setup"}}},
+	}
+	book, err := buildEPUB(site, sections, epubOptions{SiteURL: "https://goforj.dev/", Release: "v0.24.1", Modified: time.Unix(0, 0)})
+	if err != nil {
+		t.Fatalf("buildEPUB() error = %v", err)
+	}
+
+	if len(book.Chapters) != 2 || book.Chapters[0].Page.File != "guide/setup.md" || len(book.Sections) != 1 {
+		t.Fatalf("buildEPUB() chapters = %+v, sections = %+v, want setup then overview in one section", book.Chapters, book.Sections)
+	}
+	if len(book.Skipped) != 1 || !strings.Contains(book.Skipped[0], "/guide/faq") {
+		t.Fatalf("buildEPUB() skipped = %v, want the missing FAQ page", book.Skipped)
+	}
+	if !strings.Contains(book.Chapters[0].Body, "See v0.24.1.") || !strings.Contains(book.Chapters[0].Body, `<h2 id="install-it">`) {
+		t.Fatalf("buildEPUB() setup chapter =\n%s\nwant release token expanded and heading anchor", book.Chapters[0].Body)
+	}
+
+	overview := book.Chapters[1].Body
+	for _, want := range []string{
+		`<a href="chapter-001.xhtml#install-it">setup</a>`,
+		`<a href="https://goforj.dev/blog/post">the blog</a>`,
+		`<a href="https://goforj.dev/guide/faq">the FAQ</a>`,
+		`<img src="images/assets/flow.png" alt="diagram"/>`,
+		`<img src="images/assets/flow.png" alt="again"/><br/>kept`,
+		"badge",
+	} {
+		if !strings.Contains(overview, want) {
+			t.Fatalf("buildEPUB() overview chapter =\n%s\nwant %q", overview, want)
+		}
+	}
+	if strings.Contains(overview, "shields.io") || strings.Contains(overview, "align=") || strings.Contains(overview, "mywidget") {
+		t.Fatalf("buildEPUB() overview chapter =\n%s\nwant remote images, presentational attributes and components removed", overview)
+	}
+	if len(book.Images) != 1 || book.RemoteImages != 1 {
+		t.Fatalf("buildEPUB() images = %+v, remote = %d, want one embedded and one remote", book.Images, book.RemoteImages)
+	}
+}
+
+// TestWriteEPUBProducesWellFormedPackage verifies the mimetype entry leads uncompressed and every XML document parses.
+func TestWriteEPUBProducesWellFormedPackage(t *testing.T) {
+	t.Parallel()
+
+	book := epubBook{
+		Title:      epubTitle,
+		Identifier: "urn:goforj-docs:main",
+		Modified:   time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	chapter := &epubChapter{File: "chapter-001.xhtml", Title: "Tips & Tricks", Body: "<h1 id=\"tips\">Tips</h1><p>a &lt; b</p>"}
+	book.Chapters = []*epubChapter{chapter}
+	book.Sections = []epubSection{{Title: "Getting Started", Chapters: book.Chapters}}
+
+	var buf bytes.Buffer
+	if err := writeEPUB(&buf, book); err != nil {
+		t.Fatalf("writeEPUB() error = %v", err)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("zip.NewReader() error = %v", err)
+	}
+	if first := archive.File[0]; first.Name != "mimetype" || first.Method != zip.Store {
+		t.Fatalf("writeEPUB() first entry = %s (method %d), want stored mimetype", first.Name, first.Method)
+	}
+	for _, file := range archive.File {
+		if file.Name == "mimetype" || strings.HasSuffix(file.Name, ".css") {
+			continue
+		}
+		reader, err := file.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", file.Name, err)
+		}
+		decoder := xml.NewDecoder(reader)
+		decoder.Strict = true
+		decoder.Entity = map[string]string{}
+		for {
+			if _, err := decoder.Token(); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("writeEPUB() %s is not well-formed: %v", file.Name, err)
+			}
+		}
+		reader.Close()
+	}
+}
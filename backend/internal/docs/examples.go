@@ -0,0 +1,222 @@
+package docs
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/doc"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// examplesManifestPath is the data file the site's example runner reads.
+var examplesManifestPath = filepath.Join(".vitepress", "data", "examples.json")
+
+var exampleAnchorRegex = regexp.MustCompile(`[^a-z0-9]+`)
+
+// libraryExample is one runnable program found at examples/<name>/main.go.
+type libraryExample struct {
+	Name        string
+	Title       string
+	Description string
+	Path        string
+	Source      string
+}
+
+// examplesManifest groups runnable examples by library for the site's example runner.
+type examplesManifest struct {
+	Libraries []examplesManifestLibrary `json:"libraries"`
+}
+
+type examplesManifestLibrary struct {
+	Slug     string                  `json:"slug"`
+	Title    string                  `json:"title"`
+	Page     string                  `json:"page"`
+	Module   string                  `json:"module,omitempty"`
+	Commit   string                  `json:"commit,omitempty"`
+	Examples []examplesManifestEntry `json:"examples"`
+}
+
+type examplesManifestEntry struct {
+	Name        string `json:"name"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Anchor      string `json:"anchor"`
+	Path        string `json:"path"`
+	SourceURL   string `json:"sourceUrl"`
+	RawURL      string `json:"rawUrl"`
+	Run         string `json:"run"`
+	Code        string `json:"code"`
+}
+
+// examplesOutputPath nests the catalog beside the API reference under the library page name.
+func examplesOutputPath(repo RepoConfig) string {
+	return filepath.Join(filepath.Dir(repo.OutputPath), libraryPageName(repo), "examples.md")
+}
+
+// examplesRoute is the VitePress route for examplesOutputPath; nested library pages are not rewritten.
+func examplesRoute(repo RepoConfig) string {
+	return "/" + filepath.ToSlash(strings.TrimSuffix(examplesOutputPath(repo), ".md"))
+}
+
+// loadLibraryExamples only considers examples/<name>/main.go so helper packages inside examples are not listed as programs.
+func loadLibraryExamples(dir string) ([]libraryExample, error) {
+	entries, err := os.ReadDir(filepath.Join(dir, "examples"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read examples: %w", err)
+	}
+
+	var examples []libraryExample
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+			continue
+		}
+		relPath := "examples/" + name + "/main.go"
+		source, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(relPath)))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("read %s: %w", relPath, err)
+		}
+		example, err := parseLibraryExample(name, relPath, string(source))
+		if err != nil {
+			return nil, err
+		}
+		examples = append(examples, example)
+	}
+	sort.Slice(examples, func(i, j int) bool { return examples[i].Name < examples[j].Name })
+	return examples, nil
+}
+
+// parseLibraryExample takes the title from the package comment's first sentence, like go doc does for synopses.
+func parseLibraryExample(name string, relPath string, source string) (libraryExample, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), relPath, source, parser.PackageClauseOnly|parser.ParseComments)
+	if err != nil {
+		return libraryExample{}, fmt.Errorf("parse %s: %w", relPath, err)
+	}
+
+	example := libraryExample{Name: name, Path: relPath, Source: source}
+	if file.Doc != nil {
+		example.Title, example.Description = splitExampleComment(file.Doc.Text())
+	}
+	if example.Title == "" {
+		example.Title = exampleTitleFromName(name)
+	}
+	return example, nil
+}
+
+// splitExampleComment keeps everything after the synopsis as the description so longer package comments are not lost.
+func splitExampleComment(text string) (string, string) {
+	paragraphs := strings.Split(strings.TrimSpace(text), "\n\n")
+	first := strings.Join(strings.Fields(paragraphs[0]), " ")
+	synopsis := new(doc.Package).Synopsis(first)
+
+	var description []string
+	if rest := strings.TrimSpace(strings.TrimPrefix(first, synopsis)); rest != "" {
+		description = append(description, rest)
+	}
+	for _, paragraph := range paragraphs[1:] {
+		if paragraph = strings.Join(strings.Fields(paragraph), " "); paragraph != "" {
+			description = append(description, paragraph)
+		}
+	}
+	return strings.TrimSuffix(synopsis, "."), strings.Join(description, "\n\n")
+}
+
+// exampleTitleFromName turns directory names such as redis_store into readable titles when the program has no package comment.
+func exampleTitleFromName(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool { return r == '-' || r == '_' || r == ' ' })
+	if len(words) == 0 {
+		return name
+	}
+	words[0] = strings.ToUpper(words[0][:1]) + words[0][1:]
+	return strings.Join(words, " ")
+}
+
+func exampleAnchor(name string) string {
+	return strings.Trim(exampleAnchorRegex.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// renderExamplesPage lists every example with its full source so the catalog works without leaving the site.
+func renderExamplesPage(repo RepoConfig, meta RepoMetadata, examples []libraryExample) string {
+	title := libraryTitle(repo)
+	ref := sourceRef(repo, meta)
+	links := sourceLinks(repo)
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "---\ntitle: %s\n", strconv.Quote(title+" Examples"))
+	fmt.Fprintf(&out, "description: %s\n", strconv.Quote(fmt.Sprintf("Runnable %s examples from the library repository.", title)))
+	fmt.Fprintf(&out, "repoSlug: %s\nrepoUrl: %s\n", repo.Slug, links.web())
+	if meta.CommitSHA != "" {
+		fmt.Fprintf(&out, "sourceCommit: %s\n", strconv.Quote(meta.CommitSHA))
+	}
+	out.WriteString("---\n\n")
+	fmt.Fprintf(&out, "# %s Examples\n\n", title)
+	fmt.Fprintf(&out, "Generated from the [`examples/`](%s) directory. ", links.tree(ref, "examples"))
+	fmt.Fprintf(&out, "Run any example from a checkout with `go run ./examples/<name>`. See the [%s library page](%s) for the full guide.\n", title, libraryRoute(repo))
+
+	for _, example := range examples {
+		fmt.Fprintf(&out, "\n## %s {#%s}\n\n", example.Title, exampleAnchor(example.Name))
+		if example.Description != "" {
+			fmt.Fprintf(&out, "%s\n\n", example.Description)
+		}
+		fmt.Fprintf(&out, "[View on %s](%s) · `go run ./examples/%s`\n\n", links.name(), links.blob(ref, example.Path), example.Name)
+		fence := "```"
+		for strings.Contains(example.Source, fence) {
+			fence += "`"
+		}
+		fmt.Fprintf(&out, "%sgo\n%s\n%s\n", fence, strings.TrimRight(example.Source, "\n"), fence)
+	}
+	return out.String()
+}
+
+// examplesManifestLibraryFor carries the code inline so the runner does not depend on the host's raw file route at view time.
+func examplesManifestLibraryFor(repo RepoConfig, meta RepoMetadata, examples []libraryExample) examplesManifestLibrary {
+	ref := sourceRef(repo, meta)
+	links := sourceLinks(repo)
+	library := examplesManifestLibrary{
+		Slug:     repo.Slug,
+		Title:    libraryTitle(repo),
+		Page:     examplesRoute(repo),
+		Module:   meta.ModulePath,
+		Commit:   meta.CommitSHA,
+		Examples: []examplesManifestEntry{},
+	}
+	for _, example := range examples {
+		library.Examples = append(library.Examples, examplesManifestEntry{
+			Name:        example.Name,
+			Title:       example.Title,
+			Description: example.Description,
+			Anchor:      exampleAnchor(example.Name),
+			Path:        example.Path,
+			SourceURL:   links.blob(ref, example.Path),
+			RawURL:      links.raw(ref, example.Path),
+			Run:         "go run ./examples/" + example.Name,
+			Code:        example.Source,
+		})
+	}
+	return library
+}
+
+// renderExamplesManifest keeps registry order so the runner's library picker matches the sidebar.
+func renderExamplesManifest(libraries []examplesManifestLibrary) (string, error) {
+	manifest := examplesManifest{Libraries: libraries}
+	if manifest.Libraries == nil {
+		manifest.Libraries = []examplesManifestLibrary{}
+	}
+	encoded, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(encoded) + "\n", nil
+}
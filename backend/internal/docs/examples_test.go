@@ -0,0 +1,97 @@
+package docs
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestLoadLibraryExamplesReadsPackageComments verifies titles come from package comments and directories without main.go are skipped.
+func TestLoadLibraryExamplesReadsPackageComments(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFiles(t, dir, map[string]string{
+		"examples/remember/main.go":    "// Remember caches an expensive lookup. It reuses the value on the second call.\n//\n// Requires a running Redis server.\npackage main\n\nfunc main() {}\n",
+		"examples/redis_store/main.go": "package main\n\nfunc main() {}\n",
+		"examples/shared/helpers.go":   "package shared\n",
+		"examples/README.md":           "# Examples\n",
+	})
+
+	got, err := loadLibraryExamples(dir)
+	if err != nil {
+		t.Fatalf("loadLibraryExamples() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("loadLibraryExamples() = %+v, want 2 examples", got)
+	}
+	if got[0].Name != "redis_store" || got[0].Title != "Redis store" || got[0].Description != "" {
+		t.Fatalf("examples[0] = %+v, want title derived from directory name", got[0])
+	}
+	if got[1].Title != "Remember caches an expensive lookup" {
+		t.Fatalf("examples[1].Title = %q, want package comment synopsis", got[1].Title)
+	}
+	if got[1].Description != "It reuses the value on the second call.\n\nRequires a running Redis server." {
+		t.Fatalf("examples[1].Description = %q, want remaining package comment", got[1].Description)
+	}
+}
+
+// TestLoadLibraryExamplesWithoutDirectory verifies libraries without examples produce no catalog.
+func TestLoadLibraryExamplesWithoutDirectory(t *testing.T) {
+	t.Parallel()
+
+	got, err := loadLibraryExamples(t.TempDir())
+	if err != nil || got != nil {
+		t.Fatalf("loadLibraryExamples() = %+v, %v; want nil, nil", got, err)
+	}
+}
+
+// TestRenderExamplesPageAndManifest verifies the catalog page and runner manifest link the same pinned sources.
+func TestRenderExamplesPageAndManifest(t *testing.T) {
+	t.Parallel()
+
+	repo := RepoConfig{
+		Slug:       "cache",
+		Title:      "Cache",
+		CloneURL:   "https://github.com/goforj/cache.git",
+		Branch:     "main",
+		OutputPath: filepath.Join("libraries", "cache.md"),
+	}
+	meta := RepoMetadata{ModulePath: "github.com/goforj/cache", CommitSHA: "abc123"}
+	examples := []libraryExample{{
+		Name:   "redis_store",
+		Title:  "Redis store",
+		Path:   "examples/redis_store/main.go",
+		Source: "package main\n\n// ```\nfunc main() {}\n",
+	}}
+
+	page := renderExamplesPage(repo, meta, examples)
+	for _, want := range []string{
+		"title: \"Cache Examples\"\n",
+		"## Redis store {#redis-store}\n",
+		"[View on GitHub](https://github.com/goforj/cache/blob/abc123/examples/redis_store/main.go) · `go run ./examples/redis_store`",
+		"````go\npackage main\n",
+		"See the [Cache library page](/cache)",
+	} {
+		if !strings.Contains(page, want) {
+			t.Fatalf("renderExamplesPage() missing %q in:\n%s", want, page)
+		}
+	}
+
+	encoded, err := renderExamplesManifest([]examplesManifestLibrary{examplesManifestLibraryFor(repo, meta, examples)})
+	if err != nil {
+		t.Fatalf("renderExamplesManifest() error = %v", err)
+	}
+	var manifest examplesManifest
+	if err := json.Unmarshal([]byte(encoded), &manifest); err != nil {
+		t.Fatalf("manifest is not valid JSON: %v", err)
+	}
+	entry := manifest.Libraries[0].Examples[0]
+	if manifest.Libraries[0].Page != "/libraries/cache/examples" || entry.Anchor != "redis-store" {
+		t.Fatalf("manifest = %+v, want catalog route and anchor", manifest)
+	}
+	if entry.RawURL != "https://raw.githubusercontent.com/goforj/cache/abc123/examples/redis_store/main.go" || entry.Code != examples[0].Source {
+		t.Fatalf("manifest entry = %+v, want pinned raw URL and inline code", entry)
+	}
+}
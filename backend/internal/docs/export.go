@@ -0,0 +1,108 @@
+package docs
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	exportSiteArchiveName = "goforj-docs-site.zip"
+	exportEPUBName        = "goforj-docs.epub"
+	exportSidebarPath     = ".vitepress/config.mts"
+)
+
+var sidebarSectionRegex = regexp.MustCompile(`^const \w+ = sectionSidebar\('((?:[^'\\]|\\.)*)', \[\s*$`)
+var sidebarItemRegex = regexp.MustCompile(`^\s*\{\s*text:\s*'((?:[^'\\]|\\.)*)',\s*link:\s*'([^'#]*)(?:#[^']*)?'\s*\},?\s*$`)
+
+// sidebarSection is one sectionSidebar(...) group from the VitePress config.
+type sidebarSection struct {
+	Title string
+	Items []sidebarItem
+}
+
+// sidebarItem is a sidebar entry with a literal link.
+type sidebarItem struct {
+	Text  string
+	Route string
+}
+
+// parseSidebarSections reads the sectionSidebar declarations in the order the config declares them, which is the
+// order the documentation map shows them. Entries built from template literals, such as snapshot links, are skipped.
+func parseSidebarSections(config string) []sidebarSection {
+	var sections []sidebarSection
+	var current *sidebarSection
+	for _, line := range strings.Split(config, "\n") {
+		if matches := sidebarSectionRegex.FindStringSubmatch(line); matches != nil {
+			sections = append(sections, sidebarSection{Title: unescapeSidebarString(matches[1])})
+			current = &sections[len(sections)-1]
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		if strings.HasPrefix(line, "])") {
+			current = nil
+			continue
+		}
+		if matches := sidebarItemRegex.FindStringSubmatch(line); matches != nil {
+			current.Items = append(current.Items, sidebarItem{Text: unescapeSidebarString(matches[1]), Route: matches[2]})
+		}
+	}
+	return sections
+}
+
+func unescapeSidebarString(value string) string {
+	return strings.NewReplacer(`\'`, `'`, `\\`, `\`).Replace(value)
+}
+
+// writeSiteArchive zips the built site with entries at the archive root, the layout http:serve --bundle expects.
+func writeSiteArchive(siteDir string, w io.Writer) (int, error) {
+	if _, err := os.Stat(filepath.Join(siteDir, "index.html")); err != nil {
+		return 0, fmt.Errorf("%s has no index.html; build the site first", siteDir)
+	}
+	archive := zip.NewWriter(w)
+	files := 0
+	err := filepath.WalkDir(siteDir, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(siteDir, file)
+		if err != nil {
+			return err
+		}
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		header.Method = zip.Deflate
+		target, err := archive.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		source, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer source.Close()
+		if _, err := io.Copy(target, source); err != nil {
+			return fmt.Errorf("archive %s: %w", header.Name, err)
+		}
+		files++
+		return nil
+	})
+	if err != nil {
+		return files, err
+	}
+	return files, archive.Close()
+}
@@ -0,0 +1,118 @@
+package docs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/goforj/docs/internal/logger"
+)
+
+// ExportCommand packages the docs for offline use: the built site as a zip and the markdown sources as an EPUB.
+type ExportCommand struct {
+	Output  string `name:"output" type:"path" env:"DOCS_OUTPUT_DIR" help:"Docs root holding the markdown sources (defaults to ./docs or ../docs)"`
+	Site    string `name:"site" type:"path" env:"DOCS_SITE_DIR" help:"Built site to package (defaults to backend/frontend/dist, filled by make docs-embed)"`
+	Dest    string `name:"dest" type:"path" help:"Directory for the zip and EPUB (defaults to dist/offline in the docs repo)"`
+	SiteURL string `name:"site-url" env:"SITE_URL" default:"https://goforj.dev" help:"Published site that links to pages outside the book point at"`
+	NoSite  bool   `name:"no-site" help:"Only build the EPUB"`
+	NoEPUB  bool   `name:"no-epub" help:"Only package the built site"`
+	logger  *logger.AppLogger
+}
+
+// NewDocsExportCommand creates a new ExportCommand.
+func NewDocsExportCommand(logger *logger.AppLogger) *ExportCommand {
+	return &ExportCommand{
+		logger: logger,
+	}
+}
+
+// Run writes goforj-docs-site.zip, which http:serve --bundle serves as is, and goforj-docs.epub, whose table of
+// contents follows the sidebar.
+func (c *ExportCommand) Run() error {
+	docsRoot, err := resolveDocsRoot(c.Output)
+	if err != nil {
+		return err
+	}
+	repoRoot, err := filepath.Abs(filepath.Dir(docsRoot))
+	if err != nil {
+		return err
+	}
+	dest := c.Dest
+	if dest == "" {
+		dest = filepath.Join(repoRoot, "dist", "offline")
+	}
+	if err := os.MkdirAll(dest, 0o755); err != nil {
+		return fmt.Errorf("ensure export dir: %w", err)
+	}
+
+	if !c.NoSite {
+		siteDir := c.Site
+		if siteDir == "" {
+			siteDir = filepath.Join(repoRoot, "backend", "frontend", "dist")
+		}
+		file := filepath.Join(dest, exportSiteArchiveName)
+		files, err := writeExportFile(file, func(out *os.File) (int, error) {
+			return writeSiteArchive(siteDir, out)
+		})
+		if err != nil {
+			return fmt.Errorf("package site: %w", err)
+		}
+		c.logger.Info().Any("archive", file).Any("files", files).Msg("Packaged built site; serve it with http:serve --bundle")
+	}
+
+	if !c.NoEPUB {
+		config, err := os.ReadFile(filepath.Join(docsRoot, filepath.FromSlash(exportSidebarPath)))
+		if err != nil {
+			return fmt.Errorf("read %s: %w", exportSidebarPath, err)
+		}
+		release, err := loadReleaseData(docsRoot)
+		if err != nil {
+			return err
+		}
+		site, err := loadDocsSite(docsRoot, libraryRewriteMap(defaultRepos()))
+		if err != nil {
+			return err
+		}
+		book, err := buildEPUB(site, parseSidebarSections(string(config)), epubOptions{
+			SiteURL:  c.SiteURL,
+			Release:  release.Latest,
+			Modified: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		for _, skipped := range book.Skipped {
+			c.logger.Warn().Msg(skipped)
+		}
+		file := filepath.Join(dest, exportEPUBName)
+		if _, err := writeExportFile(file, func(out *os.File) (int, error) {
+			return len(book.Chapters), writeEPUB(out, book)
+		}); err != nil {
+			return fmt.Errorf("write epub: %w", err)
+		}
+		c.logger.Info().Any("epub", file).Any("chapters", len(book.Chapters)).Any("images", len(book.Images)).Any("remoteImages", book.RemoteImages).Msg("Built EPUB")
+	}
+	return nil
+}
+
+// writeExportFile writes through a temp file so a failed export never leaves a truncated archive behind.
+func writeExportFile(file string, write func(out *os.File) (int, error)) (int, error) {
+	out, err := os.CreateTemp(filepath.Dir(file), "."+filepath.Base(file)+".*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(out.Name())
+	if err := out.Chmod(0o644); err != nil {
+		out.Close()
+		return 0, err
+	}
+	count, err := write(out)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return count, err
+	}
+	return count, os.Rename(out.Name(), file)
+}
@@ -0,0 +1,75 @@
+package docs
+
+import (
+	"archive/zip"
+	"bytes"
+	"path/filepath"
+	"testing"
+)
+
+// TestParseSidebarSectionsKeepsDeclarationOrder verifies groups and items come out in config order and computed
+// entries are skipped.
+func TestParseSidebarSectionsKeepsDeclarationOrder(t *testing.T) {
+	t.Parallel()
+
+	config := `const sectionSidebar = (text: string, items: { text: string; link: string }[]) => [{
+  text,
+  items
+}]
+
+const gettingStartedSidebar = sectionSidebar('Getting Started', [
+  { text: 'Overview', link: '/getting-started/' },
+  { text: 'Don\'t Panic', link: '/getting-started/faq' }
+])
+
+const versionsSidebar = sectionSidebar('Versions', [
+  { text: 'Changelog', link: '/versions/changelog#v0-24-0' },
+  { text: ` + "`Latest tag ${release.latest}`" + `, link: '/versions/changelog' },
+  ...versions.snapshots.map((snapshot) => ({ text: snapshot.version, link: snapshot.path }))
+])
+`
+	sections := parseSidebarSections(config)
+	if len(sections) != 2 || sections[0].Title != "Getting Started" || sections[1].Title != "Versions" {
+		t.Fatalf("parseSidebarSections() = %+v, want Getting Started then Versions", sections)
+	}
+	if len(sections[0].Items) != 2 || sections[0].Items[1] != (sidebarItem{Text: "Don't Panic", Route: "/getting-started/faq"}) {
+		t.Fatalf("parseSidebarSections() items = %+v, want unescaped text in order", sections[0].Items)
+	}
+	if len(sections[1].Items) != 1 || sections[1].Items[0].Route != "/versions/changelog" {
+		t.Fatalf("parseSidebarSections() items = %+v, want only the literal link without its fragment", sections[1].Items)
+	}
+}
+
+// TestWriteSiteArchivePutsEntriesAtRoot verifies the archive layout http:serve --bundle expects.
+func TestWriteSiteArchivePutsEntriesAtRoot(t *testing.T) {
+	t.Parallel()
+
+	site := filepath.Join(t.TempDir(), "dist")
+	writeTestFiles(t, site, map[string]string{
+		"index.html":        "<html></html>",
+		"guide/setup.html":  "<html></html>",
+		"assets/app.123.js": "console.log(1)",
+	})
+	var buf bytes.Buffer
+	files, err := writeSiteArchive(site, &buf)
+	if err != nil || files != 3 {
+		t.Fatalf("writeSiteArchive() = %d, %v, want 3 files", files, err)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("zip.NewReader() error = %v", err)
+	}
+	names := map[string]bool{}
+	for _, file := range archive.File {
+		names[file.Name] = true
+	}
+	for _, want := range []string{"index.html", "guide/setup.html", "assets/app.123.js"} {
+		if !names[want] {
+			t.Fatalf("writeSiteArchive() entries = %v, want %s", names, want)
+		}
+	}
+
+	if _, err := writeSiteArchive(t.TempDir(), &bytes.Buffer{}); err == nil {
+		t.Fatalf("writeSiteArchive() error = nil, want an error for a site without index.html")
+	}
+}
@@ -0,0 +1,328 @@
+package docs
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+const frontmatterSchemaPath = ".vitepress/data/frontmatter-schema.json"
+
+var frontmatterBlockRegex = regexp.MustCompile(`^---\r?\n((?s:.*?))\r?\n---(?:\r?\n|$)`)
+var frontmatterDateRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+var whitespaceRunRegex = regexp.MustCompile(`\s+`)
+
+// frontmatterSchema declares every known key once and, per section, which keys a page must or may set.
+// Sections are tried in order and the first whose globs match a page applies.
+type frontmatterSchema struct {
+	Fields   map[string]frontmatterField `json:"fields"`
+	Sections []*frontmatterSection       `json:"sections"`
+}
+
+// frontmatterField types are string, boolean, number, date, list, object or any. MaxLength counts characters after
+// collapsing whitespace, the same way the theme's truncateDescription measures.
+type frontmatterField struct {
+	Type      string `json:"type"`
+	MaxLength int    `json:"maxLength"`
+}
+
+type frontmatterSection struct {
+	Name     string   `json:"name"`
+	Match    []string `json:"match"`
+	Exclude  []string `json:"exclude"`
+	Required []string `json:"required"`
+	Optional []string `json:"optional"`
+	match    []*regexp.Regexp
+	exclude  []*regexp.Regexp
+}
+
+// frontmatterReport holds the problems for every page plus per-section counts for the CI summary.
+type frontmatterReport struct {
+	Problems []string
+	Pages    map[string]int
+	Failing  map[string]int
+	order    []string
+}
+
+func loadFrontmatterSchema(file string) (frontmatterSchema, error) {
+	var schema frontmatterSchema
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return schema, fmt.Errorf("read %s: %w", filepath.Base(file), err)
+	}
+	if err := json.Unmarshal(content, &schema); err != nil {
+		return schema, fmt.Errorf("parse %s: %w", filepath.Base(file), err)
+	}
+	for name, field := range schema.Fields {
+		switch field.Type {
+		case "string", "boolean", "number", "date", "list", "object", "any":
+		default:
+			return schema, fmt.Errorf("%s: field %s has unknown type %q", filepath.Base(file), name, field.Type)
+		}
+	}
+	for _, section := range schema.Sections {
+		for _, key := range append(append([]string{}, section.Required...), section.Optional...) {
+			if _, ok := schema.Fields[key]; !ok {
+				return schema, fmt.Errorf("%s: section %s lists undeclared field %s", filepath.Base(file), section.Name, key)
+			}
+		}
+		section.match = compileGlobs(section.Match)
+		section.exclude = compileGlobs(section.Exclude)
+	}
+	return schema, nil
+}
+
+func (schema frontmatterSchema) sectionFor(file string) *frontmatterSection {
+	for _, section := range schema.Sections {
+		if matchesAnyGlob(section.match, file) && !matchesAnyGlob(section.exclude, file) {
+			return section
+		}
+	}
+	return nil
+}
+
+// pageFrontmatter is one page's parsed frontmatter with the source line of each key.
+type pageFrontmatter struct {
+	File   string
+	Values map[string]*yaml.Node
+	Lines  map[string]int
+}
+
+// parsePageFrontmatter keeps yaml nodes rather than decoded values so types and lines can be reported exactly.
+func parsePageFrontmatter(file string, content string) (pageFrontmatter, error) {
+	page := pageFrontmatter{File: file, Values: map[string]*yaml.Node{}, Lines: map[string]int{}}
+	block := frontmatterBlockRegex.FindStringSubmatch(content)
+	if block == nil {
+		return page, nil
+	}
+	var document yaml.Node
+	if err := yaml.Unmarshal([]byte(block[1]), &document); err != nil {
+		return page, err
+	}
+	if len(document.Content) == 0 {
+		return page, nil
+	}
+	mapping := document.Content[0]
+	if mapping.Kind != yaml.MappingNode {
+		return page, fmt.Errorf("frontmatter is not a mapping")
+	}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		key := mapping.Content[i]
+		page.Values[key.Value] = mapping.Content[i+1]
+		page.Lines[key.Value] = key.Line + 1
+	}
+	return page, nil
+}
+
+// scalar returns a string value, or "" when the key is missing or not a scalar.
+func (page pageFrontmatter) scalar(key string) string {
+	node := page.Values[key]
+	if node == nil || node.Kind != yaml.ScalarNode {
+		return ""
+	}
+	return strings.TrimSpace(node.Value)
+}
+
+// validateFrontmatter checks each page against its section, then looks for duplicate titles within a search group.
+func validateFrontmatter(docsRoot string, files []string, schema frontmatterSchema) (frontmatterReport, error) {
+	report := frontmatterReport{Pages: map[string]int{}, Failing: map[string]int{}}
+	docsDir := filepath.Base(docsRoot)
+	location := func(file string, line int) string {
+		return fmt.Sprintf("%s:%d", filepath.Join(docsDir, filepath.FromSlash(file)), line)
+	}
+
+	var pages []pageFrontmatter
+	failing := map[string]string{}
+	for _, file := range files {
+		section := schema.sectionFor(file)
+		if section == nil {
+			continue
+		}
+		report.Pages[section.Name]++
+
+		content, err := os.ReadFile(filepath.Join(docsRoot, filepath.FromSlash(file)))
+		if err != nil {
+			return report, fmt.Errorf("read %s: %w", file, err)
+		}
+		page, err := parsePageFrontmatter(file, string(content))
+		var problems []string
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: invalid frontmatter: %v", location(file, 1), err))
+		} else {
+			for _, problem := range schema.checkPage(page, section) {
+				problems = append(problems, fmt.Sprintf("%s: %s", location(file, problem.line), problem.message))
+			}
+			pages = append(pages, page)
+		}
+		if len(problems) > 0 {
+			failing[file] = section.Name
+			report.Problems = append(report.Problems, problems...)
+		}
+	}
+
+	for _, duplicate := range duplicateTitles(pages) {
+		failing[duplicate.file] = schema.sectionFor(duplicate.file).Name
+		report.Problems = append(report.Problems, fmt.Sprintf("%s: %s", location(duplicate.file, duplicate.line), duplicate.message))
+	}
+	for _, section := range failing {
+		report.Failing[section]++
+	}
+	for _, section := range schema.Sections {
+		if report.Pages[section.Name] > 0 {
+			report.order = append(report.order, section.Name)
+		}
+	}
+	return report, nil
+}
+
+type frontmatterProblem struct {
+	file    string
+	line    int
+	message string
+}
+
+func (schema frontmatterSchema) checkPage(page pageFrontmatter, section *frontmatterSection) []frontmatterProblem {
+	var problems []frontmatterProblem
+	add := func(line int, format string, args ...any) {
+		problems = append(problems, frontmatterProblem{line: line, message: fmt.Sprintf(format, args...)})
+	}
+	allowed := map[string]struct{}{}
+	missing := map[string]struct{}{}
+	for _, key := range section.Required {
+		allowed[key] = struct{}{}
+		node := page.Values[key]
+		if node == nil || (node.Kind == yaml.ScalarNode && strings.TrimSpace(node.Value) == "" && node.Tag != "!!bool") {
+			missing[key] = struct{}{}
+			add(max(page.Lines[key], 1), "%s page is missing required %s", section.Name, key)
+		}
+	}
+	for _, key := range section.Optional {
+		allowed[key] = struct{}{}
+	}
+
+	keys := make([]string, 0, len(page.Values))
+	for key := range page.Values {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return page.Lines[keys[i]] < page.Lines[keys[j]] })
+	for _, key := range keys {
+		line := page.Lines[key]
+		if _, ok := allowed[key]; !ok {
+			add(line, "unknown key %s in section %s", key, section.Name)
+			continue
+		}
+		if _, ok := missing[key]; ok {
+			continue
+		}
+		field := schema.Fields[key]
+		node := page.Values[key]
+		if !frontmatterTypeMatches(field.Type, node) {
+			add(line, "%s must be a %s", key, field.Type)
+			continue
+		}
+		if field.MaxLength > 0 && node.Kind == yaml.ScalarNode {
+			length := utf8.RuneCountInString(strings.TrimSpace(whitespaceRunRegex.ReplaceAllString(node.Value, " ")))
+			if length > field.MaxLength {
+				add(line, "%s is %d characters; keep it within %d so it is not truncated", key, length, field.MaxLength)
+			}
+		}
+	}
+	return problems
+}
+
+func frontmatterTypeMatches(kind string, node *yaml.Node) bool {
+	switch kind {
+	case "any":
+		return true
+	case "list":
+		return node.Kind == yaml.SequenceNode
+	case "object":
+		return node.Kind == yaml.MappingNode
+	}
+	if node.Kind != yaml.ScalarNode {
+		return false
+	}
+	switch kind {
+	case "boolean":
+		return node.Tag == "!!bool"
+	case "number":
+		return node.Tag == "!!int" || node.Tag == "!!float"
+	case "date":
+		return node.Tag == "!!timestamp" || frontmatterDateRegex.MatchString(node.Value)
+	default:
+		return node.Tag != "!!null"
+	}
+}
+
+// duplicateTitles mirrors local search, which groups results by language and by Library versus Framework pages:
+// a library and a guide may share a title, but two pages in one group would be indistinguishable. Each frozen
+// snapshot is its own group.
+func duplicateTitles(pages []pageFrontmatter) []frontmatterProblem {
+	type claim struct {
+		file string
+		key  string
+	}
+	seen := map[string]claim{}
+	var problems []frontmatterProblem
+	for _, page := range pages {
+		group := searchGroup(page.File)
+		for _, key := range []string{"title", "searchTitle"} {
+			value := page.scalar(key)
+			if value == "" {
+				continue
+			}
+			id := group + "\x00" + strings.ToLower(value)
+			if first, ok := seen[id]; ok {
+				problems = append(problems, frontmatterProblem{
+					file:    page.File,
+					line:    page.Lines[key],
+					message: fmt.Sprintf("duplicate %s %q; %s already uses it as %s", key, value, first.file, first.key),
+				})
+				continue
+			}
+			seen[id] = claim{file: page.File, key: key}
+		}
+	}
+	return problems
+}
+
+func searchGroup(file string) string {
+	lang := "en"
+	for _, candidate := range translationLanguages() {
+		if strings.HasPrefix(file, candidate+"/") {
+			lang = candidate
+		}
+	}
+	mode := "framework"
+	if strings.HasPrefix(file, "libraries/") || strings.Contains(file, "/libraries/") {
+		mode = "library"
+	}
+	// A frozen /versions/<line>/ snapshot repeats the titles of the pages it copied.
+	if rest, ok := strings.CutPrefix(file, "versions/"); ok {
+		if line, _, nested := strings.Cut(rest, "/"); nested && snapshotLineRegex.MatchString(line) {
+			mode = line + "/" + mode
+		}
+	}
+	return lang + "/" + mode
+}
+
+// summary renders one aligned row per section so CI logs show where failures concentrate.
+func (report frontmatterReport) summary() string {
+	var out strings.Builder
+	fmt.Fprintf(&out, "%-12s %6s %8s\n", "section", "pages", "failing")
+	total, failing := 0, 0
+	for _, name := range report.order {
+		fmt.Fprintf(&out, "%-12s %6d %8d\n", name, report.Pages[name], report.Failing[name])
+		total += report.Pages[name]
+		failing += report.Failing[name]
+	}
+	fmt.Fprintf(&out, "%-12s %6d %8d\n", "total", total, failing)
+	return out.String()
+}
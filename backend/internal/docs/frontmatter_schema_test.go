@@ -0,0 +1,115 @@
+package docs
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestValidateFrontmatterAppliesSectionSchemas verifies required keys, types, lengths, unknown keys and per-group duplicates.
+func TestValidateFrontmatterAppliesSectionSchemas(t *testing.T) {
+	t.Parallel()
+
+	root := filepath.Join(t.TempDir(), "docs")
+	writeTestFiles(t, root, map[string]string{
+		"schema.json": `{
+  "fields": {
+    "title": {"type": "string"},
+    "description": {"type": "string", "maxLength": 20},
+    "searchTitle": {"type": "string"},
+    "date": {"type": "date"},
+    "repoSlug": {"type": "string"},
+    "sidebar": {"type": "boolean"}
+  },
+  "sections": [
+    {"name": "libraries", "match": ["libraries/*.md"], "required": ["title", "description", "repoSlug"]},
+    {"name": "blog", "match": ["blog/*.md"], "required": ["title", "description", "date"], "optional": ["sidebar"]},
+    {"name": "pages", "match": ["**/*.md"], "required": ["title", "description"], "optional": ["searchTitle"]}
+  ]
+}`,
+		"libraries/mail.md":    "---\ntitle: Mail\ndescription: Send mail.\nrepoSlug: mail\n---\n",
+		"applications/mail.md": "---\ntitle: Mail\ndescription: Send mail in an app.\n---\n",
+		"applications/jobs.md": "---\ntitle: Jobs\nsearchTitle: mail\ndescription: Queue work.\n---\n",
+		"blog/post.md":         "---\ntitle: Post\ndescription: A description that runs far too long.\ndate: May 2026\nsidebar: \"no\"\nauthor: Someone\n---\n",
+		"guide.md":             "# No frontmatter\n",
+	})
+	schema, err := loadFrontmatterSchema(filepath.Join(root, "schema.json"))
+	if err != nil {
+		t.Fatalf("loadFrontmatterSchema() error = %v", err)
+	}
+	files := []string{"applications/jobs.md", "applications/mail.md", "blog/post.md", "guide.md", "libraries/mail.md"}
+
+	report, err := validateFrontmatter(root, files, schema)
+	if err != nil {
+		t.Fatalf("validateFrontmatter() error = %v", err)
+	}
+	want := []string{
+		"docs/blog/post.md:3: description is 37 characters; keep it within 20 so it is not truncated",
+		"docs/blog/post.md:4: date must be a date",
+		"docs/blog/post.md:5: sidebar must be a boolean",
+		"docs/blog/post.md:6: unknown key author in section blog",
+		"docs/guide.md:1: pages page is missing required title",
+		"docs/guide.md:1: pages page is missing required description",
+		`docs/applications/mail.md:2: duplicate title "Mail"; applications/jobs.md already uses it as searchTitle`,
+	}
+	if strings.Join(report.Problems, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("validateFrontmatter() =\n%s\nwant\n%s", strings.Join(report.Problems, "\n"), strings.Join(want, "\n"))
+	}
+	summary := report.summary()
+	for _, row := range []string{"libraries         1        0", "blog              1        1", "pages             3        2", "total             5        3"} {
+		if !strings.Contains(summary, row) {
+			t.Fatalf("summary() missing %q in:\n%s", row, summary)
+		}
+	}
+}
+
+// TestCheckedInSchemaAcceptsNestedLibraryPages verifies the API, examples and docs.yaml pages generated under a library
+// pass the site's own schema.
+func TestCheckedInSchemaAcceptsNestedLibraryPages(t *testing.T) {
+	t.Parallel()
+
+	schema, err := loadFrontmatterSchema(filepath.Join("..", "..", "..", "docs", filepath.FromSlash(frontmatterSchemaPath)))
+	if err != nil {
+		t.Fatalf("loadFrontmatterSchema() error = %v", err)
+	}
+	repo := RepoConfig{Slug: "cache", Title: "Cache", Description: "Typed caching.", CloneURL: "https://github.com/goforj/cache.git", OutputPath: filepath.Join("libraries", "cache.md")}
+	meta := RepoMetadata{ModulePath: "github.com/goforj/cache", GoVersion: "1.24", LatestTag: "v1.2.0", CommitSHA: "abc123", CommitDate: "2026-01-02", License: "MIT"}
+	page := DocsPage{Slug: "drivers", Title: "Cache Drivers", Description: "Every cache driver.", Source: "docs/drivers.md"}
+	examples := []libraryExample{{Name: "basic", Title: "Basic", Path: "examples/basic/main.go", Source: "package main\n"}}
+
+	root := filepath.Join(t.TempDir(), "docs")
+	writeTestFiles(t, root, map[string]string{
+		"libraries/cache/api.md":       renderAPIReference(repo, meta, nil),
+		"libraries/cache/examples.md":  renderExamplesPage(repo, meta, examples),
+		docsPageOutputPath(repo, page): transformDocsPage("# Drivers\n", repo, page, rawSourceBase(repo, "main"), meta),
+	})
+	files := []string{"libraries/cache/api.md", "libraries/cache/examples.md", filepath.ToSlash(docsPageOutputPath(repo, page))}
+	for _, file := range files {
+		if section := schema.sectionFor(file).Name; section != "library-pages" {
+			t.Fatalf("sectionFor(%s) = %s, want library-pages", file, section)
+		}
+	}
+	report, err := validateFrontmatter(root, files, schema)
+	if err != nil {
+		t.Fatalf("validateFrontmatter() error = %v", err)
+	}
+	if len(report.Problems) > 0 {
+		t.Fatalf("validateFrontmatter() =\n%s\nwant no problems", strings.Join(report.Problems, "\n"))
+	}
+}
+
+// TestLoadFrontmatterSchemaRejectsUndeclaredFields verifies sections can only reference declared, typed fields.
+func TestLoadFrontmatterSchemaRejectsUndeclaredFields(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFiles(t, dir, map[string]string{
+		"undeclared.json": `{"fields": {"title": {"type": "string"}}, "sections": [{"name": "pages", "match": ["**/*.md"], "required": ["title", "summary"]}]}`,
+		"type.json":       `{"fields": {"title": {"type": "text"}}, "sections": []}`,
+	})
+	for _, file := range []string{"undeclared.json", "type.json"} {
+		if _, err := loadFrontmatterSchema(filepath.Join(dir, file)); err == nil {
+			t.Fatalf("loadFrontmatterSchema(%s) error = nil, want schema error", file)
+		}
+	}
+}
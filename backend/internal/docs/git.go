@@ -6,11 +6,12 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 )
 
-func cloneRepo(url string, dest string, branch string) (string, error) {
+func cloneRepo(url string, dest string, branch string, auth gitAuth) (string, error) {
 	if isGitRepo(dest) {
-		if err := updateRepo(dest, branch); err != nil {
+		if err := updateRepo(dest, branch, auth); err != nil {
 			return "", fmt.Errorf("update repo: %w", err)
 		}
 		return "updated", nil
@@ -20,16 +21,13 @@ func cloneRepo(url string, dest string, branch string) (string, error) {
 		return "", fmt.Errorf("clean repo dir: %w", err)
 	}
 
-	var stderr bytes.Buffer
 	args := []string{"clone", "--depth", "1"}
 	if branch != "" {
 		args = append(args, "--branch", branch)
 	}
 	args = append(args, url, dest)
-	cmd := exec.Command("git", args...)
-	cmd.Stderr = &stderr
-	if err := cmd.Run(); err != nil {
-		return "", fmt.Errorf("%w: %s", err, stderr.String())
+	if _, err := runGit(auth, args...); err != nil {
+		return "", err
 	}
 	return "cloned", nil
 }
@@ -39,31 +37,62 @@ func isGitRepo(path string) bool {
 	return err == nil && stat.IsDir()
 }
 
-func updateRepo(dest string, branch string) error {
-	var stderr bytes.Buffer
+func updateRepo(dest string, branch string, auth gitAuth) error {
 	if branch != "" {
-		fetch := exec.Command("git", "-C", dest, "fetch", "--prune", "--depth", "1", "origin", branch)
-		fetch.Stderr = &stderr
-		if err := fetch.Run(); err != nil {
-			return fmt.Errorf("%w: %s", err, stderr.String())
-		}
-		checkout := exec.Command("git", "-C", dest, "checkout", branch)
-		checkout.Stderr = &stderr
-		if err := checkout.Run(); err != nil {
-			return fmt.Errorf("%w: %s", err, stderr.String())
+		if _, err := runGit(auth, "-C", dest, "fetch", "--prune", "--depth", "1", "origin", branch); err != nil {
+			return err
 		}
-		reset := exec.Command("git", "-C", dest, "reset", "--hard", "origin/"+branch)
-		reset.Stderr = &stderr
-		if err := reset.Run(); err != nil {
-			return fmt.Errorf("%w: %s", err, stderr.String())
+		// Checkout and reset fetch missing blobs in partial clones, so they need credentials too.
+		if _, err := runGit(auth, "-C", dest, "checkout", branch); err != nil {
+			return err
 		}
-		return nil
+		_, err := runGit(auth, "-C", dest, "reset", "--hard", "origin/"+branch)
+		return err
+	}
+
+	_, err := runGit(auth, "-C", dest, "pull", "--ff-only")
+	return err
+}
+
+// gitOutput returns stdout so metadata readers can parse git plumbing output without sharing stderr.
+func gitOutput(dir string, args ...string) (string, error) {
+	return runGit(gitAuth{}, append([]string{"-C", dir}, args...)...)
+}
+
+// remoteGitOutput is gitOutput for commands that talk to origin and may need the repo's credentials.
+func remoteGitOutput(dir string, auth gitAuth, args ...string) (string, error) {
+	return runGit(auth, append([]string{"-C", dir}, args...)...)
+}
+
+func runGit(auth gitAuth, args ...string) (string, error) {
+	return runGitInput(auth, "", args...)
+}
+
+func runGitInput(auth gitAuth, stdin string, args ...string) (string, error) {
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", args...)
+	cmd.Env = auth.env()
+	cmd.Stdin = strings.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%w: %s", err, auth.redact(stderr.String()))
 	}
+	return stdout.String(), nil
+}
 
-	pull := exec.Command("git", "-C", dest, "pull", "--ff-only")
-	pull.Stderr = &stderr
-	if err := pull.Run(); err != nil {
-		return fmt.Errorf("%w: %s", err, stderr.String())
+// ensureFullHistory converts a shallow cache checkout into a blobless full clone so tag ranges and subjects are readable
+// without downloading historical file contents.
+func ensureFullHistory(dest string, auth gitAuth) error {
+	shallow, err := gitOutput(dest, "rev-parse", "--is-shallow-repository")
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(shallow) != "true" {
+		_, err := remoteGitOutput(dest, auth, "fetch", "--quiet", "--tags", "origin")
+		return err
 	}
-	return nil
+	_, err = remoteGitOutput(dest, auth, "fetch", "--quiet", "--filter=blob:none", "--unshallow", "--tags", "origin")
+	return err
 }
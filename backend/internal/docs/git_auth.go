@@ -0,0 +1,117 @@
+package docs
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// gitAuth carries per-repo credentials to git through the child process environment only,
+// so tokens never appear in argv, remote URLs, logs or the cached checkout's .git/config.
+type gitAuth struct {
+	scope  string
+	user   string
+	token  string
+	sshKey string
+}
+
+// repoGitAuth reads the token named by TokenEnv and the SSH key selected by SSHKey; public repos get the zero value.
+func repoGitAuth(repo RepoConfig) gitAuth {
+	auth := gitAuth{scope: credentialScope(repo.CloneURL), user: repo.TokenUser}
+	if repo.TokenEnv != "" {
+		auth.token = strings.TrimSpace(os.Getenv(repo.TokenEnv))
+	}
+	if auth.user == "" {
+		auth.user = defaultTokenUser(repoHostOf(repo))
+	}
+	if repo.SSHKey != "" {
+		auth.sshKey = expandHomePath(os.ExpandEnv(repo.SSHKey))
+	}
+	return auth
+}
+
+// checkCloneCredentials fails before git runs so a missing secret reads as configuration, not as an opaque clone error.
+func checkCloneCredentials(repo RepoConfig) error {
+	if parsed, err := url.Parse(repo.CloneURL); err == nil && parsed.User != nil {
+		if _, hasPassword := parsed.User.Password(); hasPassword {
+			return fmt.Errorf("clone URL for %s embeds credentials; use TokenEnv instead", repo.Slug)
+		}
+	}
+	if repo.TokenEnv != "" && strings.TrimSpace(os.Getenv(repo.TokenEnv)) == "" {
+		return fmt.Errorf("%s needs an access token in $%s", repo.Slug, repo.TokenEnv)
+	}
+	if repo.SSHKey != "" {
+		key := expandHomePath(os.ExpandEnv(repo.SSHKey))
+		if _, err := os.Stat(key); err != nil {
+			return fmt.Errorf("ssh key for %s: %w", repo.Slug, err)
+		}
+	}
+	return nil
+}
+
+// defaultTokenUser is the username each forge expects alongside a personal or installation access token.
+func defaultTokenUser(host RepoHost) string {
+	if host == HostGitHub {
+		return "x-access-token"
+	}
+	return "oauth2"
+}
+
+// credentialScope limits the auth header to the repository itself so redirects or submodules on other hosts never receive it.
+func credentialScope(cloneURL string) string {
+	parsed, err := url.Parse(cloneURL)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") {
+		return ""
+	}
+	parsed.User = nil
+	parsed.RawQuery = ""
+	parsed.Fragment = ""
+	return parsed.String()
+}
+
+// env appends git's config-by-environment variables, which apply to this process only and are never persisted.
+func (a gitAuth) env() []string {
+	if a.token == "" && a.sshKey == "" {
+		return nil
+	}
+	env := append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if a.token != "" && a.scope != "" {
+		credentials := base64.StdEncoding.EncodeToString([]byte(a.user + ":" + a.token))
+		env = append(env,
+			"GIT_CONFIG_COUNT=1",
+			"GIT_CONFIG_KEY_0=http."+a.scope+".extraHeader",
+			"GIT_CONFIG_VALUE_0=Authorization: Basic "+credentials,
+		)
+	}
+	if a.sshKey != "" {
+		env = append(env, "GIT_SSH_COMMAND=ssh -i "+shellQuote(a.sshKey)+" -o IdentitiesOnly=yes")
+	}
+	return env
+}
+
+// redact scrubs the token and its encoded header from git output before it is wrapped into an error.
+func (a gitAuth) redact(output string) string {
+	if a.token == "" {
+		return output
+	}
+	encoded := base64.StdEncoding.EncodeToString([]byte(a.user + ":" + a.token))
+	return strings.NewReplacer(a.token, "[redacted]", encoded, "[redacted]").Replace(output)
+}
+
+func expandHomePath(value string) string {
+	if value != "~" && !strings.HasPrefix(value, "~/") {
+		return value
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return value
+	}
+	return filepath.Join(home, strings.TrimPrefix(value, "~"))
+}
+
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
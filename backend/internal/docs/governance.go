@@ -0,0 +1,140 @@
+package docs
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const governancePath = "ai/governance.json"
+
+var governanceDateRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// governanceHistoryPrefixes are public pages that record past releases, where old versions and paths are expected.
+var governanceHistoryPrefixes = []string{"versions/"}
+
+// governanceManifest is ai/governance.json: which planning docs are current guidance and which references they must not carry.
+type governanceManifest struct {
+	ActiveAsOf                string   `json:"activeAsOf"`
+	Active                    []string `json:"active"`
+	Historical                []string `json:"historical"`
+	Supporting                []string `json:"supporting"`
+	ForbiddenActiveReferences []string `json:"forbiddenActiveReferences"`
+	lines                     []string
+}
+
+func loadGovernanceManifest(file string) (governanceManifest, error) {
+	var manifest governanceManifest
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return manifest, fmt.Errorf("read %s: %w", governancePath, err)
+	}
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return manifest, fmt.Errorf("parse %s: %w", governancePath, err)
+	}
+	manifest.lines = strings.Split(string(content), "\n")
+	return manifest, nil
+}
+
+// lineOf finds the manifest line holding a quoted value so problems point at the entry to edit.
+func (manifest governanceManifest) lineOf(value string) int {
+	quoted := fmt.Sprintf("%q", value)
+	for i, line := range manifest.lines {
+		if strings.Contains(line, quoted) {
+			return i + 1
+		}
+	}
+	return 1
+}
+
+// lintGovernance checks the manifest against the repository and returns problems as path:line:column: message, relative to repoRoot.
+func lintGovernance(repoRoot string, docsRoot string, manifest governanceManifest) ([]string, error) {
+	var problems []string
+	if !governanceDateRegex.MatchString(manifest.ActiveAsOf) {
+		problems = append(problems, fmt.Sprintf("%s:%d:1: activeAsOf must use YYYY-MM-DD", governancePath, manifest.lineOf("activeAsOf")))
+	}
+
+	listed := map[string]struct{}{}
+	for _, group := range [][]string{manifest.Active, manifest.Historical, manifest.Supporting} {
+		for _, file := range group {
+			listed[file] = struct{}{}
+			if _, err := os.Stat(filepath.Join(repoRoot, filepath.FromSlash(file))); err != nil {
+				problems = append(problems, fmt.Sprintf("%s:%d:1: listed document %s is missing", governancePath, manifest.lineOf(file), file))
+			}
+		}
+	}
+	aiFiles, err := filepath.Glob(filepath.Join(repoRoot, "ai", "*.md"))
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range aiFiles {
+		rel := path.Join("ai", filepath.Base(file))
+		if _, ok := listed[rel]; !ok {
+			problems = append(problems, fmt.Sprintf("%s:1:1: classify %s as active, supporting, or historical", governancePath, rel))
+		}
+	}
+
+	sources := append([]string{}, manifest.Active...)
+	pages, err := governedPublicPages(repoRoot, docsRoot)
+	if err != nil {
+		return nil, err
+	}
+	sources = append(sources, pages...)
+	for _, file := range sources {
+		content, err := os.ReadFile(filepath.Join(repoRoot, filepath.FromSlash(file)))
+		if err != nil {
+			continue
+		}
+		problems = append(problems, forbiddenReferenceProblems(file, string(content), manifest.ForbiddenActiveReferences)...)
+	}
+	return problems, nil
+}
+
+// governedPublicPages lists site Markdown relative to repoRoot, leaving release history pages out.
+func governedPublicPages(repoRoot string, docsRoot string) ([]string, error) {
+	site, err := loadDocsSite(docsRoot, nil)
+	if err != nil {
+		return nil, err
+	}
+	docsRel, err := filepath.Rel(repoRoot, docsRoot)
+	if err != nil {
+		return nil, err
+	}
+	var pages []string
+	for _, file := range site.Files {
+		historical := false
+		for _, prefix := range governanceHistoryPrefixes {
+			historical = historical || strings.HasPrefix(file, prefix)
+		}
+		if !historical {
+			pages = append(pages, path.Join(filepath.ToSlash(docsRel), file))
+		}
+	}
+	sort.Strings(pages)
+	return pages, nil
+}
+
+// forbiddenReferenceProblems reports every occurrence with a 1-based line and byte column so editors can jump to it.
+func forbiddenReferenceProblems(file string, content string, forbidden []string) []string {
+	var problems []string
+	for i, line := range strings.Split(content, "\n") {
+		for _, reference := range forbidden {
+			offset := 0
+			for {
+				index := strings.Index(line[offset:], reference)
+				if index < 0 {
+					break
+				}
+				column := offset + index + 1
+				problems = append(problems, fmt.Sprintf("%s:%d:%d: stale reference %s is forbidden in active docs", file, i+1, column, reference))
+				offset = column - 1 + len(reference)
+			}
+		}
+	}
+	return problems
+}
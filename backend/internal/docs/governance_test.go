@@ -0,0 +1,44 @@
+package docs
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestLintGovernanceReportsJumpableViolations verifies missing and unclassified docs plus stale references in active and public pages.
+func TestLintGovernanceReportsJumpableViolations(t *testing.T) {
+	t.Parallel()
+
+	repoRoot := t.TempDir()
+	writeTestFiles(t, repoRoot, map[string]string{
+		"ai/vision.md":            "# Vision\n\nSee core/runtime-lifecycle and again core/runtime-lifecycle.\n",
+		"ai/roadmap.md":           "Shipped v0.20.0.\n",
+		"ai/draft.md":             "# Draft\n",
+		"docs/index.md":           "# Home\n\nInstall v0.20.0.\n",
+		"docs/versions/log.md":    "## v0.20.0\n",
+		"docs/.vitepress/note.md": "v0.20.0\n",
+	})
+	manifest := governanceManifest{
+		ActiveAsOf:                "2026-07-30",
+		Active:                    []string{"ai/vision.md", "ai/missing.md"},
+		Historical:                []string{"ai/roadmap.md"},
+		ForbiddenActiveReferences: []string{"v0.20.0", "core/runtime-lifecycle"},
+		lines:                     []string{"{", `  "active": [`, `    "ai/vision.md",`, `    "ai/missing.md"`},
+	}
+
+	got, err := lintGovernance(repoRoot, filepath.Join(repoRoot, "docs"), manifest)
+	if err != nil {
+		t.Fatalf("lintGovernance() error = %v", err)
+	}
+	want := []string{
+		"ai/governance.json:4:1: listed document ai/missing.md is missing",
+		"ai/governance.json:1:1: classify ai/draft.md as active, supporting, or historical",
+		"ai/vision.md:3:5: stale reference core/runtime-lifecycle is forbidden in active docs",
+		"ai/vision.md:3:38: stale reference core/runtime-lifecycle is forbidden in active docs",
+		"docs/index.md:3:9: stale reference v0.20.0 is forbidden in active docs",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("lintGovernance() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
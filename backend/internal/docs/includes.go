@@ -0,0 +1,93 @@
+package docs
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// includeDirectiveRegex matches VitePress include directives with an optional {start,end} line range.
+var includeDirectiveRegex = regexp.MustCompile(`<!--\s*@include:\s*([^\s{}]+?)(?:\{(\d*),(\d*)\})?\s*-->`)
+
+// maxIncludeDepth bounds nested includes so a cycle fails instead of recursing forever.
+const maxIncludeDepth = 8
+
+// includeTargets lists the repo-relative files a page includes, in directive order.
+func includeTargets(content string, sourceDir string) []string {
+	var targets []string
+	for _, match := range includeDirectiveRegex.FindAllStringSubmatch(content, -1) {
+		if target, ok := includeTargetPath(match[1], sourceDir); ok {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+func includeTargetPath(value string, sourceDir string) (string, bool) {
+	target := path.Clean(repoRelativePath(value, sourceDir))
+	return target, isRepoLocalPath(target)
+}
+
+// expandIncludes inlines include directives from the checkout, because the generated page lives outside the source
+// repository and VitePress would resolve the same directive against the docs tree. Included content has its
+// relative links and images rewritten against its own directory before it is spliced in.
+func expandIncludes(content string, dir string, sourceDir string, rewrite func(content string, includeDir string) string) (string, error) {
+	return expandIncludesDepth(content, dir, sourceDir, rewrite, 0)
+}
+
+func expandIncludesDepth(content string, dir string, sourceDir string, rewrite func(string, string) string, depth int) (string, error) {
+	var firstErr error
+	expanded := includeDirectiveRegex.ReplaceAllStringFunc(content, func(directive string) string {
+		if firstErr != nil {
+			return directive
+		}
+		parts := includeDirectiveRegex.FindStringSubmatch(directive)
+		target, ok := includeTargetPath(parts[1], sourceDir)
+		if !ok {
+			firstErr = fmt.Errorf("include %q must stay inside the repository", parts[1])
+			return directive
+		}
+		if depth >= maxIncludeDepth {
+			firstErr = fmt.Errorf("include %s: nested more than %d levels deep", target, maxIncludeDepth)
+			return directive
+		}
+		included, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(target)))
+		if err != nil {
+			firstErr = fmt.Errorf("include %s: %w", target, err)
+			return directive
+		}
+		body := includeLineRange(string(included), parts[2], parts[3])
+		includeDir := sourceDirOf(target)
+		body, err = expandIncludesDepth(body, dir, includeDir, rewrite, depth+1)
+		if err != nil {
+			firstErr = err
+			return directive
+		}
+		return strings.TrimRight(rewrite(body, includeDir), "\n")
+	})
+	return expanded, firstErr
+}
+
+// includeLineRange applies VitePress's 1-based, inclusive {start,end} range where either bound may be omitted.
+func includeLineRange(content string, start string, end string) string {
+	if start == "" && end == "" {
+		return content
+	}
+	lines := strings.Split(content, "\n")
+	from := 1
+	to := len(lines)
+	if value, err := strconv.Atoi(start); err == nil && value > 0 {
+		from = value
+	}
+	if value, err := strconv.Atoi(end); err == nil && value < to {
+		to = value
+	}
+	if from > to {
+		return ""
+	}
+	return strings.Join(lines[from-1:to], "\n")
+}
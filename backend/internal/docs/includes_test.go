@@ -0,0 +1,48 @@
+package docs
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestExpandIncludesInlinesFilesRelativeToTheirDirectory verifies nested includes, line ranges and per-file link rewriting.
+func TestExpandIncludesInlinesFilesRelativeToTheirDirectory(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFiles(t, dir, map[string]string{
+		"docs/parts/usage.md":  "skip\n## Usage\n\n![Flow](../img/flow.svg)\n<!--@include: ./footer.md-->\nskip\n",
+		"docs/parts/footer.md": "See [drivers](drivers/).\n",
+	})
+	repo := RepoConfig{Slug: "cache", CloneURL: "https://github.com/goforj/cache.git", Branch: "main"}
+	rawBase := rawSourceBase(repo, "main")
+	rewrite := func(content string, includeDir string) string {
+		return rewriteMarkdownLinks(rewriteImageLinks(content, rawBase, includeDir), repo, includeDir)
+	}
+
+	got, err := expandIncludes("# Cache\n\n<!--@include: ./docs/parts/usage.md{2,5}-->\n", dir, "", rewrite)
+	if err != nil {
+		t.Fatalf("expandIncludes() error = %v", err)
+	}
+	want := "# Cache\n\n## Usage\n\n![Flow](https://raw.githubusercontent.com/goforj/cache/main/docs/img/flow.svg)\n" +
+		"See [drivers](https://github.com/goforj/cache/tree/main/docs/parts/drivers/).\n"
+	if got != want {
+		t.Fatalf("expandIncludes() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+// TestExpandIncludesRejectsEscapesAndCycles verifies includes cannot read outside the checkout or recurse forever.
+func TestExpandIncludesRejectsEscapesAndCycles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFiles(t, dir, map[string]string{"loop.md": "<!--@include: loop.md-->\n"})
+	identity := func(content string, _ string) string { return content }
+
+	if _, err := expandIncludes("<!--@include: ../secret.md-->", dir, "", identity); err == nil || !strings.Contains(err.Error(), "inside the repository") {
+		t.Fatalf("expandIncludes() error = %v, want escape rejection", err)
+	}
+	if _, err := expandIncludes("<!--@include: loop.md-->", dir, "", identity); err == nil || !strings.Contains(err.Error(), "levels deep") {
+		t.Fatalf("expandIncludes() error = %v, want depth error", err)
+	}
+}
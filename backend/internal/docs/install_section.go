@@ -0,0 +1,99 @@
+package docs
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var installHeadingRegex = regexp.MustCompile(`(?i)^#{1,6}\s+(?:<a id="[^"]*"></a>\s*)?(?:install|installation|installing)\b`)
+var pageTitleHeadingRegex = regexp.MustCompile(`^#\s+\S`)
+var sectionHeadingRegex = regexp.MustCompile(`^##\s+\S`)
+
+// insertInstallSection runs before heading IDs are assigned so the generated heading claims its anchor like source-owned sections.
+func insertInstallSection(content string, meta RepoMetadata) string {
+	if meta.ModulePath == "" || hasInstallSection(content) {
+		return content
+	}
+
+	lines := strings.Split(content, "\n")
+	insertAt := installSectionPosition(lines)
+	section := strings.Split(strings.TrimRight(renderInstallSection(meta), "\n"), "\n")
+	if insertAt > 0 && strings.TrimSpace(lines[insertAt-1]) != "" {
+		section = append([]string{""}, section...)
+	}
+	if insertAt >= len(lines) || strings.TrimSpace(lines[insertAt]) != "" {
+		section = append(section, "")
+	}
+
+	updated := make([]string, 0, len(lines)+len(section))
+	updated = append(updated, lines[:insertAt]...)
+	updated = append(updated, section...)
+	updated = append(updated, lines[insertAt:]...)
+	return strings.Join(updated, "\n")
+}
+
+// hasInstallSection leaves source-owned install guidance untouched, including HTML-anchored headings from generated READMEs.
+func hasInstallSection(content string) bool {
+	found := false
+	forEachProseLine(strings.Split(content, "\n"), func(_ int, line string) {
+		if installHeadingRegex.MatchString(strings.TrimSpace(line)) {
+			found = true
+		}
+	})
+	return found
+}
+
+// installSectionPosition places the block under the README title, or ahead of the first section for hero-style READMEs without one.
+func installSectionPosition(lines []string) int {
+	title := -1
+	firstSection := -1
+	forEachProseLine(lines, func(index int, line string) {
+		trimmed := strings.TrimSpace(line)
+		if title == -1 && pageTitleHeadingRegex.MatchString(trimmed) {
+			title = index
+		}
+		if firstSection == -1 && sectionHeadingRegex.MatchString(trimmed) {
+			firstSection = index
+		}
+	})
+	switch {
+	case title != -1 && (firstSection == -1 || title < firstSection):
+		return title + 1
+	case firstSection != -1:
+		return firstSection
+	default:
+		return len(lines)
+	}
+}
+
+// renderInstallSection pins the newest release installable at the module path so copied commands match the generated page.
+func renderInstallSection(meta RepoMetadata) string {
+	version := meta.LatestTag
+	if version == "" {
+		version = "latest"
+	}
+
+	var out strings.Builder
+	out.WriteString("## Install\n\n")
+	fmt.Fprintf(&out, "```bash\ngo get %s@%s\n```\n\n", meta.ModulePath, version)
+	fmt.Fprintf(&out, "```go\nimport %q\n```\n", meta.ModulePath)
+	if meta.GoVersion != "" {
+		fmt.Fprintf(&out, "\nRequires Go %s or newer.\n", meta.GoVersion)
+	}
+	return out.String()
+}
+
+// forEachProseLine visits lines outside fenced code blocks so shell or Markdown samples are never mistaken for page structure.
+func forEachProseLine(lines []string, visit func(index int, line string)) {
+	inCode := false
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCode = !inCode
+			continue
+		}
+		if !inCode {
+			visit(i, line)
+		}
+	}
+}
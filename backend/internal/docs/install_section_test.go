@@ -0,0 +1,85 @@
+package docs
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestInsertInstallSectionAfterTitle verifies the install block pins the newest tag directly under the README title.
+func TestInsertInstallSectionAfterTitle(t *testing.T) {
+	t.Parallel()
+
+	meta := RepoMetadata{ModulePath: "github.com/goforj/atlas", GoVersion: "1.24", LatestTag: "v0.3.0"}
+	got := insertInstallSection("# Atlas\n\nAgent tooling.\n\n## Usage\n", meta)
+	want := strings.Join([]string{
+		"# Atlas",
+		"",
+		"## Install",
+		"",
+		"```bash",
+		"go get github.com/goforj/atlas@v0.3.0",
+		"```",
+		"",
+		"```go",
+		`import "github.com/goforj/atlas"`,
+		"```",
+		"",
+		"Requires Go 1.24 or newer.",
+		"",
+		"Agent tooling.",
+		"",
+		"## Usage",
+		"",
+	}, "\n")
+	if got != want {
+		t.Fatalf("insertInstallSection() =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+// TestInsertInstallSectionBeforeFirstSection verifies hero-style READMEs keep their banner above the install block.
+func TestInsertInstallSectionBeforeFirstSection(t *testing.T) {
+	t.Parallel()
+
+	meta := RepoMetadata{ModulePath: "github.com/goforj/cache"}
+	got := insertInstallSection("<p align=\"center\">logo</p>\n\n## Drivers\n", meta)
+	if !strings.HasPrefix(got, "<p align=\"center\">logo</p>\n\n## Install\n") {
+		t.Fatalf("insertInstallSection() did not follow the hero block:\n%s", got)
+	}
+	if !strings.Contains(got, "go get github.com/goforj/cache@latest\n") {
+		t.Fatalf("insertInstallSection() did not fall back to @latest:\n%s", got)
+	}
+	if strings.Contains(got, "Requires Go") {
+		t.Fatalf("insertInstallSection() wrote an unknown Go version:\n%s", got)
+	}
+}
+
+// TestInsertInstallSectionPreservesExistingInstructions verifies source-owned install sections are not duplicated.
+func TestInsertInstallSectionPreservesExistingInstructions(t *testing.T) {
+	t.Parallel()
+
+	meta := RepoMetadata{ModulePath: "github.com/goforj/cache", LatestTag: "v1.0.0"}
+	for _, input := range []string{
+		"# Cache\n\n## Installation\n\n```bash\ngo get github.com/goforj/cache\n```\n",
+		"# Crypt\n\n## Install\n",
+		"# Cache\n\n#### <a id=\"install\"></a>Install\n",
+	} {
+		if got := insertInstallSection(input, meta); got != input {
+			t.Fatalf("insertInstallSection() changed README with install section:\n%s", got)
+		}
+	}
+	if got := insertInstallSection("# Cache\n", RepoMetadata{}); got != "# Cache\n" {
+		t.Fatalf("insertInstallSection() changed README without a module path:\n%s", got)
+	}
+}
+
+// TestInsertInstallSectionIgnoresFencedHeadings verifies Markdown samples are not treated as page structure.
+func TestInsertInstallSectionIgnoresFencedHeadings(t *testing.T) {
+	t.Parallel()
+
+	meta := RepoMetadata{ModulePath: "github.com/goforj/str"}
+	input := "```md\n# Sample\n## Install\n```\n\n## Usage\n"
+	got := insertInstallSection(input, meta)
+	if !strings.Contains(got, "```\n\n## Install\n") || !strings.HasSuffix(got, "\n## Usage\n") {
+		t.Fatalf("insertInstallSection() used fenced headings:\n%s", got)
+	}
+}
@@ -0,0 +1,350 @@
+package docs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const externalLinksPath = ".vitepress/data/external-links.json"
+
+var markdownLinkRegex = regexp.MustCompile(`(!?)\[(?:[^\[\]]|\[[^\]]*\])*\]\(\s*<?([^()\s<>]*(?:\([^()\s]*\)[^()\s<>]*)*)>?(?:\s+(?:"[^"]*"|'[^']*'))?\s*\)`)
+var referenceDefinitionRegex = regexp.MustCompile(`^\s{0,3}\[[^\]]+\]:\s*<?(\S+?)>?(?:\s|$)`)
+var htmlLinkAttrRegex = regexp.MustCompile(`(?i)<(a|img|source)\b[^>]*\s(href|src)\s*=\s*["']([^"']+)["']`)
+var htmlIDAttrRegex = regexp.MustCompile(`(?i)<[a-z][^>]*\sid\s*=\s*["']([^"']+)["']`)
+var frontmatterLinkRegex = regexp.MustCompile(`^\s*(?:-\s+)?link:\s*["']?([^"'\s]+)["']?\s*$`)
+var inlineCodeRegex = regexp.MustCompile("(`+)[^`]*?(`+)")
+var headingTextCleanupRegex = regexp.MustCompile(`!\[[^\]]*\]\([^)]*\)|<[^>]+>`)
+var headingLinkTextRegex = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+var slugSpecialRegex = regexp.MustCompile("[\\s~`!@#$%^&*()\\-_+=\\[\\]{}|\\\\;:\"'“”‘’<>,.?/]+")
+
+// docsPage is one Markdown source with the route VitePress serves it at and the anchors it renders.
+type docsPage struct {
+	File    string
+	Route   string
+	Anchors map[string]struct{}
+}
+
+// docsSite indexes every page by route so links can be resolved the way the built site would.
+type docsSite struct {
+	Root  string
+	Pages map[string]*docsPage
+	Files []string
+}
+
+// docLink is a link target found on a source line.
+type docLink struct {
+	Line   int
+	Target string
+	Asset  bool
+}
+
+// externalLinkCache is the offline allowlist consulted for http(s) links; no request is ever made.
+type externalLinkCache struct {
+	AllowPrefixes []string `json:"allowPrefixes"`
+	URLs          []string `json:"urls"`
+}
+
+// libraryRewriteMap mirrors the VitePress libraryRewrites: libraries/<page>.md is served from <page>.md.
+func libraryRewriteMap(repos []RepoConfig) map[string]string {
+	rewrites := map[string]string{}
+	for _, repo := range repos {
+		rewrites[filepath.ToSlash(repo.OutputPath)] = strings.TrimPrefix(libraryRoute(repo), "/") + ".md"
+	}
+	return rewrites
+}
+
+// loadDocsSite walks the docs root like VitePress does, skipping .vitepress, public assets and installed packages.
+func loadDocsSite(root string, rewrites map[string]string) (docsSite, error) {
+	site := docsSite{Root: root, Pages: map[string]*docsPage{}}
+	err := filepath.WalkDir(root, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			name := entry.Name()
+			if file != root && (strings.HasPrefix(name, ".") || name == "node_modules" || name == "public") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(file) != ".md" {
+			return nil
+		}
+		rel, err := filepath.Rel(root, file)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", rel, err)
+		}
+		served := rel
+		if rewritten, ok := rewrites[rel]; ok {
+			served = rewritten
+		}
+		page := &docsPage{File: rel, Route: pageRoute(served), Anchors: pageAnchors(string(content))}
+		site.Pages[page.Route] = page
+		site.Files = append(site.Files, rel)
+		return nil
+	})
+	sort.Strings(site.Files)
+	return site, err
+}
+
+// pageRoute applies cleanUrls: guide/index.md is served at /guide and guide/setup.md at /guide/setup.
+func pageRoute(file string) string {
+	route := strings.TrimSuffix(file, ".md")
+	if route == "index" {
+		return "/"
+	}
+	route = strings.TrimSuffix(route, "/index")
+	return "/" + route
+}
+
+// normalizeRoute maps every spelling VitePress accepts for a page onto pageRoute's form.
+func normalizeRoute(target string) string {
+	cleaned := path.Clean("/" + target)
+	for _, ext := range []string{".md", ".html"} {
+		cleaned = strings.TrimSuffix(cleaned, ext)
+	}
+	if cleaned == "/index" {
+		return "/"
+	}
+	return strings.TrimSuffix(cleaned, "/index")
+}
+
+// servedDir is the directory relative page links resolve against, which follows the rewritten route rather than the source file.
+func (site docsSite) servedDir(page *docsPage) string {
+	if strings.HasSuffix(page.File, "/index.md") || page.File == "index.md" {
+		return page.Route
+	}
+	return path.Dir(page.Route)
+}
+
+// pageAnchors collects heading IDs, explicit {#id} or slugified with -1, -2 suffixes, plus id attributes in inline HTML.
+func pageAnchors(content string) map[string]struct{} {
+	anchors := map[string]struct{}{}
+	forEachProseLine(strings.Split(content, "\n"), func(_ int, line string) {
+		for _, match := range htmlIDAttrRegex.FindAllStringSubmatch(line, -1) {
+			anchors[match[1]] = struct{}{}
+		}
+		headingAnchor(line, anchors)
+	})
+	return anchors
+}
+
+// headingAnchor returns the ID a heading line renders with and reserves it, or "" when the line is not a heading.
+func headingAnchor(line string, anchors map[string]struct{}) string {
+	matches := markdownHeadingRegex.FindStringSubmatch(line)
+	if len(matches) != 3 {
+		return ""
+	}
+	if explicit := headingWithIDRegex.FindStringSubmatch(line); len(explicit) == 4 {
+		anchors[explicit[3]] = struct{}{}
+		return explicit[3]
+	}
+	title := strings.TrimSpace(strings.TrimRight(matches[2], "#"))
+	slug := vitepressSlug(headingText(title))
+	if slug == "" {
+		return ""
+	}
+	unique := slug
+	for i := 1; ; i++ {
+		if _, taken := anchors[unique]; !taken {
+			break
+		}
+		unique = fmt.Sprintf("%s-%d", slug, i)
+	}
+	anchors[unique] = struct{}{}
+	return unique
+}
+
+// headingText keeps what markdown-it passes to slugify: text and inline code, without images, tags or link targets.
+func headingText(title string) string {
+	text := headingTextCleanupRegex.ReplaceAllString(title, "")
+	return headingLinkTextRegex.ReplaceAllString(text, "$1")
+}
+
+// vitepressSlug ports the default VitePress slugify so auto-generated heading IDs can be predicted offline.
+func vitepressSlug(text string) string {
+	var out strings.Builder
+	for _, r := range norm.NFKD.String(text) {
+		if unicode.Is(unicode.Mn, r) || r < 0x20 {
+			continue
+		}
+		out.WriteRune(r)
+	}
+	slug := slugSpecialRegex.ReplaceAllString(out.String(), "-")
+	slug = strings.Trim(slug, "-")
+	if slug != "" && slug[0] >= '0' && slug[0] <= '9' {
+		slug = "_" + slug
+	}
+	return strings.ToLower(slug)
+}
+
+// extractDocLinks lists link targets outside code fences and inline code, including frontmatter link: values.
+func extractDocLinks(content string) []docLink {
+	lines := strings.Split(content, "\n")
+	var links []docLink
+	start := 0
+	if len(lines) > 0 && strings.TrimSpace(lines[0]) == "---" {
+		for i := 1; i < len(lines); i++ {
+			if strings.TrimSpace(lines[i]) == "---" {
+				start = i + 1
+				break
+			}
+			if matches := frontmatterLinkRegex.FindStringSubmatch(lines[i]); len(matches) == 2 {
+				links = append(links, docLink{Line: i + 1, Target: matches[1]})
+			}
+		}
+	}
+	forEachProseLine(lines[start:], func(index int, line string) {
+		lineNumber := start + index + 1
+		line = inlineCodeRegex.ReplaceAllStringFunc(line, func(code string) string {
+			return strings.Repeat(" ", len(code))
+		})
+		for _, match := range markdownLinkRegex.FindAllStringSubmatch(line, -1) {
+			links = append(links, docLink{Line: lineNumber, Target: match[2], Asset: match[1] == "!"})
+		}
+		if matches := referenceDefinitionRegex.FindStringSubmatch(line); len(matches) == 2 {
+			links = append(links, docLink{Line: lineNumber, Target: matches[1]})
+		}
+		for _, match := range htmlLinkAttrRegex.FindAllStringSubmatch(line, -1) {
+			links = append(links, docLink{Line: lineNumber, Target: match[3], Asset: !strings.EqualFold(match[1], "a")})
+		}
+	})
+	return links
+}
+
+// loadExternalLinkCache reads the allowlist; a missing file allows nothing so --external reports every external URL.
+func loadExternalLinkCache(file string) (externalLinkCache, error) {
+	var cache externalLinkCache
+	content, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return cache, nil
+	}
+	if err != nil {
+		return cache, err
+	}
+	if err := json.Unmarshal(content, &cache); err != nil {
+		return cache, fmt.Errorf("parse %s: %w", filepath.Base(file), err)
+	}
+	return cache, nil
+}
+
+// allows matches the URL without its fragment against exact entries and prefixes.
+func (cache externalLinkCache) allows(target string) bool {
+	if cut := strings.Index(target, "#"); cut >= 0 {
+		target = target[:cut]
+	}
+	for _, url := range cache.URLs {
+		if target == strings.TrimSuffix(url, "#") {
+			return true
+		}
+	}
+	for _, prefix := range cache.AllowPrefixes {
+		if strings.HasPrefix(target, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkSiteLinks resolves every link on every page and returns editor-jumpable problems. A nil cache skips external URLs.
+func checkSiteLinks(site docsSite, external *externalLinkCache) ([]string, error) {
+	docsDir := filepath.Base(site.Root)
+	byFile := map[string]*docsPage{}
+	for _, page := range site.Pages {
+		byFile[page.File] = page
+	}
+	var problems []string
+	for _, file := range site.Files {
+		page := byFile[file]
+		content, err := os.ReadFile(filepath.Join(site.Root, filepath.FromSlash(file)))
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", file, err)
+		}
+		for _, link := range extractDocLinks(string(content)) {
+			if problem := site.checkLink(page, link, external); problem != "" {
+				problems = append(problems, fmt.Sprintf("%s:%d: %s", filepath.Join(docsDir, filepath.FromSlash(file)), link.Line, problem))
+			}
+		}
+	}
+	return problems, nil
+}
+
+// checkLink skips Vue interpolations and %%RELEASE%% tokens, which only resolve at build time.
+func (site docsSite) checkLink(page *docsPage, link docLink, external *externalLinkCache) string {
+	target := link.Target
+	lower := strings.ToLower(target)
+	switch {
+	case target == "" || strings.Contains(target, "{{") || strings.Contains(target, "%%") || strings.HasPrefix(lower, "mailto:") || strings.HasPrefix(lower, "tel:") || strings.HasPrefix(lower, "data:") || strings.HasPrefix(lower, "javascript:"):
+		return ""
+	case strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://") || strings.HasPrefix(target, "//"):
+		if external != nil && !external.allows(target) {
+			return fmt.Sprintf("external link %s is not in %s", target, externalLinksPath)
+		}
+		return ""
+	case strings.Contains(target, "://"):
+		return ""
+	}
+
+	pathPart, fragment, _ := strings.Cut(target, "#")
+	if cut := strings.Index(pathPart, "?"); cut >= 0 {
+		pathPart = pathPart[:cut]
+	}
+	ext := path.Ext(pathPart)
+	if link.Asset || (ext != "" && ext != ".md" && ext != ".html") {
+		if site.assetExists(page, pathPart) {
+			return ""
+		}
+		return fmt.Sprintf("missing file %s", target)
+	}
+
+	targetPage := page
+	if pathPart != "" {
+		route := pathPart
+		if !strings.HasPrefix(route, "/") {
+			route = path.Join(site.servedDir(page), route)
+		}
+		targetPage = site.Pages[normalizeRoute(route)]
+		if targetPage == nil {
+			return fmt.Sprintf("broken link %s: no page at %s", target, normalizeRoute(route))
+		}
+	}
+	if fragment == "" {
+		return ""
+	}
+	if _, ok := targetPage.Anchors[fragment]; !ok {
+		return fmt.Sprintf("broken anchor %s: %s has no heading #%s", target, targetPage.File, fragment)
+	}
+	return ""
+}
+
+// assetExists follows Vite resolution: absolute paths come from public/ or the docs root, relative ones from the source file.
+func (site docsSite) assetExists(page *docsPage, target string) bool {
+	var candidates []string
+	if strings.HasPrefix(target, "/") {
+		candidates = []string{path.Join("public", target), path.Clean(target[1:])}
+	} else {
+		candidates = []string{path.Join(path.Dir(page.File), target)}
+	}
+	for _, candidate := range candidates {
+		if _, err := os.Stat(filepath.Join(site.Root, filepath.FromSlash(candidate))); err == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package docs
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/goforj/docs/internal/logger"
+)
+
+// LinksCommand checks internal links and anchors across the docs source without building the site.
+type LinksCommand struct {
+	Output        string `name:"output" type:"path" env:"DOCS_OUTPUT_DIR" help:"Docs root to check (defaults to ./docs or ../docs)"`
+	External      bool   `name:"external" help:"Also require external URLs to appear in the external link allowlist (no network access)"`
+	ExternalCache string `name:"external-cache" type:"path" help:"Allowlist of external URLs and prefixes (defaults to .vitepress/data/external-links.json under the docs root)"`
+	logger        *logger.AppLogger
+}
+
+// NewDocsLinksCommand creates a new LinksCommand.
+func NewDocsLinksCommand(logger *logger.AppLogger) *LinksCommand {
+	return &LinksCommand{
+		logger: logger,
+	}
+}
+
+// Run resolves every Markdown link against cleanUrls routes, library rewrites and heading IDs, and prints each broken one.
+func (c *LinksCommand) Run() error {
+	docsRoot, err := resolveDocsRoot(c.Output)
+	if err != nil {
+		return err
+	}
+	site, err := loadDocsSite(docsRoot, libraryRewriteMap(defaultRepos()))
+	if err != nil {
+		return err
+	}
+	c.logger.Info().Any("output", docsRoot).Any("pages", len(site.Pages)).Msg("Indexed docs pages")
+
+	var external *externalLinkCache
+	if c.External {
+		cachePath := c.ExternalCache
+		if cachePath == "" {
+			cachePath = filepath.Join(docsRoot, filepath.FromSlash(externalLinksPath))
+		}
+		cache, err := loadExternalLinkCache(cachePath)
+		if err != nil {
+			return err
+		}
+		external = &cache
+	}
+
+	problems, err := checkSiteLinks(site, external)
+	if err != nil {
+		return err
+	}
+	for _, problem := range problems {
+		fmt.Println(problem)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("found %d broken link(s)", len(problems))
+	}
+	c.logger.Info().Any("pages", len(site.Pages)).Msg("All docs links resolve")
+	return nil
+}
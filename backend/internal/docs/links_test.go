@@ -0,0 +1,80 @@
+package docs
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestVitepressSlugMatchesDefaultSlugify verifies auto-generated anchors follow VitePress for punctuation, accents and leading digits.
+func TestVitepressSlugMatchesDefaultSlugify(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"Create a Command":              "create-a-command",
+		"Using `cache.Remember()`":      "using-cache-remember",
+		"Café & Crème":                  "cafe-creme",
+		"2. Install":                    "_2-install",
+		"Why [Atlas](/atlas)?":          "why-atlas",
+		"Drivers <Badge text=\"new\"/>": "drivers",
+	}
+	for title, want := range cases {
+		if got := vitepressSlug(headingText(title)); got != want {
+			t.Fatalf("vitepressSlug(%q) = %q, want %q", title, got, want)
+		}
+	}
+}
+
+// TestCheckSiteLinksResolvesRoutesRewritesAndAnchors verifies cleanUrls spellings, library rewrites, duplicate slugs and assets.
+func TestCheckSiteLinksResolvesRoutesRewritesAndAnchors(t *testing.T) {
+	t.Parallel()
+
+	root := filepath.Join(t.TempDir(), "docs")
+	writeTestFiles(t, root, map[string]string{
+		"index.md":             "---\nactions:\n  - link: /guide/\n  - link: /guide/missing\n---\n# Home\n",
+		"guide/index.md":       "# Guide\n\n## Setup\n\n## Setup\n\n[Dup](#setup-1) [Next](setup#setup) [Cache](/cache#cache) [Old](/libraries/cache)\n",
+		"guide/setup.md":       "# Setup\n\n[Back](./index.md#guide) [Nope](#nothing) [Img](../public/x.png)\n\n![Logo](/logo.svg)\n\n```md\n[fenced](/nowhere)\n```\n\nUse `[code](/nowhere)` inline.\n",
+		"libraries/cache.md":   "# Cache {#cache}\n\n[Guide](guide/setup) [Sibling](./queue)\n\n<a href=\"https://example.com/x#y\">x</a>\n",
+		"libraries/queue.md":   "# Queue\n",
+		"public/logo.svg":      "<svg/>",
+		".vitepress/config.md": "[ignored](/nowhere)\n",
+	})
+	repos := []RepoConfig{
+		{Slug: "cache", OutputPath: filepath.Join("libraries", "cache.md")},
+		{Slug: "queue", OutputPath: filepath.Join("libraries", "queue.md")},
+	}
+
+	site, err := loadDocsSite(root, libraryRewriteMap(repos))
+	if err != nil {
+		t.Fatalf("loadDocsSite() error = %v", err)
+	}
+	problems, err := checkSiteLinks(site, &externalLinkCache{AllowPrefixes: []string{"https://example.com/"}})
+	if err != nil {
+		t.Fatalf("checkSiteLinks() error = %v", err)
+	}
+	want := []string{
+		"docs/guide/index.md:7: broken link /libraries/cache: no page at /libraries/cache",
+		"docs/guide/setup.md:3: broken anchor #nothing: guide/setup.md has no heading #nothing",
+		"docs/guide/setup.md:3: missing file ../public/x.png",
+		"docs/index.md:4: broken link /guide/missing: no page at /guide/missing",
+	}
+	if strings.Join(problems, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("checkSiteLinks() =\n%s\nwant\n%s", strings.Join(problems, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+// TestExternalLinkCacheAllowsWithoutNetwork verifies external URLs are matched offline, ignoring fragments.
+func TestExternalLinkCacheAllowsWithoutNetwork(t *testing.T) {
+	t.Parallel()
+
+	cache := externalLinkCache{AllowPrefixes: []string{"https://github.com/goforj/"}, URLs: []string{"https://go.dev/doc/"}}
+	for target, want := range map[string]bool{
+		"https://github.com/goforj/cache#readme": true,
+		"https://go.dev/doc/#install":            true,
+		"https://go.dev/blog/":                   false,
+	} {
+		if got := cache.allows(target); got != want {
+			t.Fatalf("allows(%q) = %v, want %v", target, got, want)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package docs
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/goforj/docs/internal/logger"
+)
+
+// LintCommand enforces ai/governance.json against the planning docs and public pages.
+type LintCommand struct {
+	Output     string `name:"output" type:"path" env:"DOCS_OUTPUT_DIR" help:"Docs root holding the public pages (defaults to ./docs or ../docs)"`
+	Governance string `name:"governance" type:"path" help:"Governance manifest (defaults to ai/governance.json beside the docs root)"`
+	logger     *logger.AppLogger
+}
+
+// NewDocsLintCommand creates a new LintCommand.
+func NewDocsLintCommand(logger *logger.AppLogger) *LintCommand {
+	return &LintCommand{
+		logger: logger,
+	}
+}
+
+// Run prints each violation as path:line:column: message and fails when any are found.
+func (c *LintCommand) Run() error {
+	docsRoot, err := resolveDocsRoot(c.Output)
+	if err != nil {
+		return err
+	}
+	repoRoot := filepath.Dir(docsRoot)
+	manifestPath := c.Governance
+	if manifestPath == "" {
+		manifestPath = filepath.Join(repoRoot, filepath.FromSlash(governancePath))
+	}
+	manifest, err := loadGovernanceManifest(manifestPath)
+	if err != nil {
+		return err
+	}
+	c.logger.Info().Any("governance", manifestPath).Any("active", len(manifest.Active)).Msg("Loaded governance manifest")
+
+	problems, err := lintGovernance(repoRoot, docsRoot, manifest)
+	if err != nil {
+		return err
+	}
+	for _, problem := range problems {
+		fmt.Println(problem)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("governance rules violated in %d place(s)", len(problems))
+	}
+	c.logger.Info().Msg("Docs follow governance rules")
+	return nil
+}
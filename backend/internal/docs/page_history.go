@@ -0,0 +1,200 @@
+package docs
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const pageHistoryPath = ".vitepress/data/page-history.json"
+
+// pageHistoryLogFormat emits one record per commit: SHA, author date, author and Co-authored-by trailers. Author dates
+// match what VitePress's own lastUpdated reads from git.
+const pageHistoryLogFormat = "--format=%x1e%H%x1f%aI%x1f%aN%x1f%(trailers:key=Co-authored-by,valueonly,separator=%x1d)"
+
+// pageHistory is one page's entry in docs/.vitepress/data/page-history.json. Repo names the library whose upstream
+// commit is newest when that commit did not come from this repo.
+type pageHistory struct {
+	LastUpdated string   `json:"lastUpdated"`
+	Commit      string   `json:"commit"`
+	Repo        string   `json:"repo,omitempty"`
+	Authors     []string `json:"authors"`
+}
+
+// pageHistoryManifest maps source paths relative to the docs root, the key VitePress exposes as pageData.filePath.
+type pageHistoryManifest struct {
+	Pages map[string]pageHistory `json:"pages"`
+}
+
+// fileHistory is the newest commit and every author seen for one file.
+type fileHistory struct {
+	Date    time.Time
+	Commit  string
+	Authors map[string]struct{}
+}
+
+// merge folds another file's history in, keeping the newer commit.
+func (history *fileHistory) merge(other *fileHistory) {
+	if other.Date.After(history.Date) {
+		history.Date = other.Date
+		history.Commit = other.Commit
+	}
+	for author := range other.Authors {
+		history.Authors[author] = struct{}{}
+	}
+}
+
+// gitFileHistories reads `git log --name-only` once for the pathspecs under dir and returns each file's history keyed
+// by its path relative to dir. Walking the log newest first means the first commit seen for a file is its last.
+func gitFileHistories(dir string, pathspecs ...string) (map[string]*fileHistory, error) {
+	prefix, err := gitOutput(dir, "rev-parse", "--show-prefix")
+	if err != nil {
+		return nil, err
+	}
+	prefix = strings.TrimSpace(prefix)
+	output, err := gitOutput(dir, append([]string{"log", "--name-only", "--no-renames", pageHistoryLogFormat, "--"}, pathspecs...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	histories := map[string]*fileHistory{}
+	for _, record := range strings.Split(output, "\x1e") {
+		header, files, _ := strings.Cut(record, "\n")
+		fields := strings.Split(header, "\x1f")
+		if len(fields) != 4 {
+			continue
+		}
+		date, err := time.Parse(time.RFC3339, fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("parse commit date %q: %w", fields[1], err)
+		}
+		authors := []string{fields[2]}
+		for _, coauthor := range strings.Split(fields[3], "\x1d") {
+			name, _, _ := strings.Cut(coauthor, "<")
+			if name = strings.TrimSpace(name); name != "" {
+				authors = append(authors, name)
+			}
+		}
+		for _, file := range strings.Split(files, "\n") {
+			file = strings.TrimSpace(file)
+			if file == "" || !strings.HasPrefix(file, prefix) {
+				continue
+			}
+			rel := strings.TrimPrefix(file, prefix)
+			history, ok := histories[rel]
+			if !ok {
+				history = &fileHistory{Date: date, Commit: fields[0], Authors: map[string]struct{}{}}
+				histories[rel] = history
+			}
+			for _, author := range authors {
+				history.Authors[author] = struct{}{}
+			}
+		}
+	}
+	return histories, nil
+}
+
+// librarySources maps each page docs:generate writes for a library to the upstream files it is rendered from: the
+// README and its includes, extra docs pages, and translated READMEs.
+func librarySources(repo RepoConfig, dir string) map[string][]string {
+	readmePath := repo.ReadmePath
+	if readmePath == "" {
+		readmePath = "README.md"
+	}
+	withIncludes := func(source string) []string {
+		sources := []string{source}
+		content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(source)))
+		if err != nil {
+			return sources
+		}
+		return append(sources, includeTargets(string(content), sourceDirOf(source))...)
+	}
+
+	pages := map[string][]string{
+		filepath.ToSlash(repo.OutputPath): withIncludes(readmePath),
+	}
+	for _, page := range repo.Pages {
+		pages[filepath.ToSlash(docsPageOutputPath(repo, page))] = withIncludes(page.Source)
+	}
+	for _, lang := range translationLanguages() {
+		pages[filepath.ToSlash(translationOutputPath(repo, lang))] = withIncludes(localizedReadmePath(readmePath, lang))
+	}
+	return pages
+}
+
+// upstreamPageHistories combines the history of every upstream source behind each generated page of one library.
+func upstreamPageHistories(repo RepoConfig, dir string) (map[string]*fileHistory, error) {
+	sources := librarySources(repo, dir)
+	var pathspecs []string
+	for _, files := range sources {
+		pathspecs = append(pathspecs, files...)
+	}
+	sort.Strings(pathspecs)
+	histories, err := gitFileHistories(dir, pathspecs...)
+	if err != nil {
+		return nil, err
+	}
+	pages := map[string]*fileHistory{}
+	for page, files := range sources {
+		for _, file := range files {
+			history, ok := histories[path.Clean(file)]
+			if !ok {
+				continue
+			}
+			if pages[page] == nil {
+				pages[page] = &fileHistory{Authors: map[string]struct{}{}}
+			}
+			pages[page].merge(history)
+		}
+	}
+	return pages, nil
+}
+
+// buildPageHistory writes an entry for every page with history in the docs repo. Generated library pages also fold in
+// their upstream history, so a README edit dates the page even before the docs repo regenerates it.
+func buildPageHistory(files []string, docs map[string]*fileHistory, upstream map[string]map[string]*fileHistory) pageHistoryManifest {
+	manifest := pageHistoryManifest{Pages: map[string]pageHistory{}}
+	for _, file := range files {
+		history := &fileHistory{Authors: map[string]struct{}{}}
+		repo := ""
+		if local, ok := docs[file]; ok {
+			history.merge(local)
+		}
+		for slug, pages := range upstream {
+			if library, ok := pages[file]; ok {
+				if library.Date.After(history.Date) {
+					repo = slug
+				}
+				history.merge(library)
+			}
+		}
+		if history.Commit == "" {
+			continue
+		}
+		authors := make([]string, 0, len(history.Authors))
+		for author := range history.Authors {
+			authors = append(authors, author)
+		}
+		sort.Strings(authors)
+		manifest.Pages[file] = pageHistory{
+			LastUpdated: history.Date.UTC().Format(time.RFC3339),
+			Commit:      history.Commit,
+			Repo:        repo,
+			Authors:     authors,
+		}
+	}
+	return manifest
+}
+
+func renderPageHistory(manifest pageHistoryManifest) (string, error) {
+	content, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(content) + "\n", nil
+}
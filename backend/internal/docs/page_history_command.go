@@ -0,0 +1,94 @@
+package docs
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/goforj/docs/internal/logger"
+)
+
+// HistoryCommand records each page's last commit and authors so the VitePress build never needs git.
+type HistoryCommand struct {
+	Output   string `name:"output" type:"path" env:"DOCS_OUTPUT_DIR" help:"Docs root inside a git checkout (defaults to ./docs or ../docs)"`
+	CacheDir string `name:"cache-dir" type:"path" env:"DOCS_CACHE_DIR" help:"Directory holding the library checkouts synced by docs:generate (defaults to the system temp dir)"`
+	NoFetch  bool   `name:"no-fetch" help:"Do not deepen shallow library checkouts; their upstream history is skipped instead"`
+	logger   *logger.AppLogger
+}
+
+// NewDocsHistoryCommand creates a new HistoryCommand.
+func NewDocsHistoryCommand(logger *logger.AppLogger) *HistoryCommand {
+	return &HistoryCommand{
+		logger: logger,
+	}
+}
+
+// Run writes docs/.vitepress/data/page-history.json from the docs repo's history plus, for generated library pages,
+// the history of their upstream sources in the cached checkouts. Libraries without a checkout keep docs repo history.
+func (c *HistoryCommand) Run() error {
+	docsRoot, err := resolveDocsRoot(c.Output)
+	if err != nil {
+		return err
+	}
+	docs, err := gitFileHistories(docsRoot, ".")
+	if err != nil {
+		return fmt.Errorf("read docs history (run where .git is available): %w", err)
+	}
+	site, err := loadDocsSite(docsRoot, nil)
+	if err != nil {
+		return err
+	}
+
+	cacheRoot, err := resolveCacheRoot(c.CacheDir)
+	if err != nil {
+		return err
+	}
+	upstream := map[string]map[string]*fileHistory{}
+	for _, repo := range defaultRepos() {
+		pages, err := c.upstreamHistory(repo, checkoutDir(cacheRoot, repo.Slug))
+		if err != nil {
+			c.logger.Warn().Any("repo", repo.Slug).Err(err).Msg("Skipped upstream history; the page keeps docs repo history")
+			continue
+		}
+		if pages != nil {
+			upstream[repo.Slug] = pages
+		}
+	}
+
+	manifest := buildPageHistory(site.Files, docs, upstream)
+	rendered, err := renderPageHistory(manifest)
+	if err != nil {
+		return err
+	}
+	file := filepath.Join(docsRoot, filepath.FromSlash(pageHistoryPath))
+	if err := writeGeneratedPage(file, rendered); err != nil {
+		return fmt.Errorf("write %s: %w", pageHistoryPath, err)
+	}
+	c.logger.Info().Any("pages", len(manifest.Pages)).Any("libraries", len(upstream)).Msg("Wrote page history")
+	return nil
+}
+
+// upstreamHistory returns nil without an error when the library has no checkout to read.
+func (c *HistoryCommand) upstreamHistory(repo RepoConfig, dir string) (map[string]*fileHistory, error) {
+	if !isGitRepo(dir) {
+		c.logger.Warn().Any("repo", repo.Slug).Msg("No library checkout (run docs:generate first); the page keeps docs repo history")
+		return nil, nil
+	}
+	merged, err := applyRepoDocsConfig(repo, dir)
+	if err != nil {
+		return nil, err
+	}
+	shallow, err := gitOutput(dir, "rev-parse", "--is-shallow-repository")
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(shallow) == "true" {
+		if c.NoFetch {
+			return nil, fmt.Errorf("checkout is shallow and --no-fetch is set")
+		}
+		if err := ensureFullHistory(dir, repoGitAuth(merged)); err != nil {
+			return nil, fmt.Errorf("fetch history: %w", err)
+		}
+	}
+	return upstreamPageHistories(merged, dir)
+}
@@ -0,0 +1,84 @@
+package docs
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestPageHistoryCombinesDocsAndUpstreamCommits verifies paths are keyed from the docs root, co-authors count as
+// authors, and a newer upstream README include dates the generated library page.
+func TestPageHistoryCombinesDocsAndUpstreamCommits(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	runTestGit(t, root, "init", "-q")
+	writeTestFiles(t, root, map[string]string{
+		"README.md":               "# Docs repo\n",
+		"docs/guide.md":           "# Guide\n",
+		"docs/libraries/queue.md": "# Queue\n",
+	})
+	runTestGit(t, root, "add", ".")
+	runTestGit(t, root, "commit", "-q", "-m", "Initial docs")
+	writeTestFiles(t, root, map[string]string{"docs/guide.md": "# Guide\n\nMore.\n"})
+	runTestGit(t, root, "commit", "-q", "-a", "--author", "Ana <ana@example.com>", "--date", "2026-08-03T09:00:00Z", "-m", "Expand guide\n\nCo-authored-by: Bo <bo@example.com>")
+	expanded := headCommit(t, root)
+
+	docs, err := gitFileHistories(filepath.Join(root, "docs"), ".")
+	if err != nil {
+		t.Fatalf("gitFileHistories() error = %v", err)
+	}
+	if _, ok := docs["README.md"]; ok || len(docs) != 2 {
+		t.Fatalf("gitFileHistories() = %v, want only the two docs pages", docs)
+	}
+	if guide := docs["guide.md"]; guide.Commit != expanded || len(guide.Authors) != 3 {
+		t.Fatalf("gitFileHistories() guide = %+v, want the newest commit and authors Docs, Ana and Bo", guide)
+	}
+
+	library := t.TempDir()
+	runTestGit(t, library, "init", "-q")
+	writeTestFiles(t, library, map[string]string{
+		"README.md":       "# Queue\n\n<!-- @include: ./docs/install.md -->\n",
+		"docs/install.md": "go get\n",
+		"queue.go":        "package queue\n",
+	})
+	runTestGit(t, library, "add", ".")
+	runTestGit(t, library, "commit", "-q", "-m", "Initial library")
+	writeTestFiles(t, library, map[string]string{"docs/install.md": "go get -u\n", "queue.go": "package queue // v2\n"})
+	runTestGit(t, library, "commit", "-q", "-a", "--author", "Lib <lib@example.com>", "--date", "2026-08-05T12:00:00Z", "-m", "Update install")
+	upstreamCommit := headCommit(t, library)
+
+	repo := RepoConfig{Slug: "queue", OutputPath: filepath.Join("libraries", "queue.md")}
+	upstream, err := upstreamPageHistories(repo, library)
+	if err != nil {
+		t.Fatalf("upstreamPageHistories() error = %v", err)
+	}
+
+	manifest := buildPageHistory([]string{"guide.md", "libraries/queue.md", "new.md"}, docs, map[string]map[string]*fileHistory{"queue": upstream})
+	want := map[string]pageHistory{
+		"guide.md": {
+			LastUpdated: "2026-08-03T09:00:00Z",
+			Commit:      expanded,
+			Authors:     []string{"Ana", "Bo", "Docs"},
+		},
+		"libraries/queue.md": {
+			LastUpdated: "2026-08-05T12:00:00Z",
+			Commit:      upstreamCommit,
+			Repo:        "queue",
+			Authors:     []string{"Docs", "Lib"},
+		},
+	}
+	if !reflect.DeepEqual(manifest.Pages, want) {
+		t.Fatalf("buildPageHistory() = %+v, want %+v", manifest.Pages, want)
+	}
+}
+
+func headCommit(t *testing.T, dir string) string {
+	t.Helper()
+	output, err := gitOutput(dir, "rev-parse", "HEAD")
+	if err != nil {
+		t.Fatalf("git rev-parse: %v", err)
+	}
+	return strings.TrimSpace(output)
+}
@@ -6,6 +6,74 @@ import (
 	"path/filepath"
 )
 
+// defaultCacheDirName keeps the historical checkout cache location so existing volumes and build caches stay warm.
+const defaultCacheDirName = "goforj-docs"
+
+// fingerprintDirName is nested inside the cache root so one cache volume owns both checkouts and page fingerprints.
+const fingerprintDirName = ".docs-generate-fingerprints"
+
+// resolveDocsRoot prefers an explicit output root so parallel or scratch builds never depend on the working directory.
+func resolveDocsRoot(output string) (string, error) {
+	if output == "" {
+		return findDocsRoot()
+	}
+
+	absolute, err := filepath.Abs(output)
+	if err != nil {
+		return "", fmt.Errorf("resolve docs output %q: %w", output, err)
+	}
+	info, err := os.Stat(absolute)
+	if err == nil && !info.IsDir() {
+		return "", fmt.Errorf("docs output %q is not a directory", output)
+	}
+	if err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("read docs output %q: %w", output, err)
+	}
+	if err := os.MkdirAll(absolute, 0o755); err != nil {
+		return "", fmt.Errorf("ensure docs output %q: %w", output, err)
+	}
+
+	return absolute, nil
+}
+
+// writeGeneratedPage skips identical output so unchanged sources do not touch file timestamps.
+func writeGeneratedPage(outputPath string, content string) error {
+	if generatedPageMatches(outputPath, content) {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(outputPath, []byte(content), 0o644)
+}
+
+// resolveCacheRoot falls back to the shared temp cache when no persistent cache volume is configured.
+func resolveCacheRoot(cacheDir string) (string, error) {
+	if cacheDir == "" {
+		return filepath.Join(os.TempDir(), defaultCacheDirName), nil
+	}
+
+	absolute, err := filepath.Abs(cacheDir)
+	if err != nil {
+		return "", fmt.Errorf("resolve cache dir %q: %w", cacheDir, err)
+	}
+	info, err := os.Stat(absolute)
+	if err == nil && !info.IsDir() {
+		return "", fmt.Errorf("cache dir %q is not a directory", cacheDir)
+	}
+
+	return absolute, nil
+}
+
+// siblingCheckout is a repository cloned beside this one, such as ../goforj. The docs root may be relative, so the
+// repository root is made absolute before stepping out of it.
+func siblingCheckout(repoRoot string, name string) string {
+	if absolute, err := filepath.Abs(repoRoot); err == nil {
+		repoRoot = absolute
+	}
+	return filepath.Join(filepath.Dir(repoRoot), name)
+}
+
 func findDocsRoot() (string, error) {
 	candidates := []string{
 		"docs",
@@ -19,5 +87,5 @@ func findDocsRoot() (string, error) {
 		}
 	}
 
-	return "", fmt.Errorf("docs directory not found from current working directory")
+	return "", fmt.Errorf("docs directory not found from current working directory (use --output or DOCS_OUTPUT_DIR)")
 }
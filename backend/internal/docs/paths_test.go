@@ -0,0 +1,68 @@
+package docs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestResolveDocsRootCreatesExplicitOutput verifies scratch builds can target a directory that does not exist yet.
+func TestResolveDocsRootCreatesExplicitOutput(t *testing.T) {
+	t.Parallel()
+
+	output := filepath.Join(t.TempDir(), "scratch", "docs")
+	got, err := resolveDocsRoot(output)
+	if err != nil {
+		t.Fatalf("resolveDocsRoot() error = %v", err)
+	}
+	if got != output {
+		t.Fatalf("resolveDocsRoot() = %q, want %q", got, output)
+	}
+	if info, err := os.Stat(got); err != nil || !info.IsDir() {
+		t.Fatalf("resolveDocsRoot() did not create %q: %v", got, err)
+	}
+}
+
+// TestResolveDocsRootRejectsFiles verifies generated pages are never written beneath a regular file.
+func TestResolveDocsRootRejectsFiles(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "docs")
+	if err := os.WriteFile(file, []byte("test"), 0o644); err != nil {
+		t.Fatalf("write test file: %v", err)
+	}
+	if _, err := resolveDocsRoot(file); err == nil || !strings.Contains(err.Error(), "not a directory") {
+		t.Fatalf("resolveDocsRoot() error = %v, want directory error", err)
+	}
+}
+
+// TestResolveCacheRoot verifies the default temp cache and explicit persistent cache locations.
+func TestResolveCacheRoot(t *testing.T) {
+	t.Parallel()
+
+	got, err := resolveCacheRoot("")
+	if err != nil {
+		t.Fatalf("resolveCacheRoot() error = %v", err)
+	}
+	if want := filepath.Join(os.TempDir(), defaultCacheDirName); got != want {
+		t.Fatalf("resolveCacheRoot() = %q, want %q", got, want)
+	}
+
+	directory := filepath.Join(t.TempDir(), "cache")
+	got, err = resolveCacheRoot(directory)
+	if err != nil {
+		t.Fatalf("resolveCacheRoot() error = %v", err)
+	}
+	if got != directory {
+		t.Fatalf("resolveCacheRoot() = %q, want %q", got, directory)
+	}
+
+	file := filepath.Join(t.TempDir(), "cache")
+	if err := os.WriteFile(file, []byte("test"), 0o644); err != nil {
+		t.Fatalf("write test file: %v", err)
+	}
+	if _, err := resolveCacheRoot(file); err == nil || !strings.Contains(err.Error(), "not a directory") {
+		t.Fatalf("resolveCacheRoot() error = %v, want directory error", err)
+	}
+}
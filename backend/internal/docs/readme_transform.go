@@ -18,12 +18,15 @@ var frameworkGuideHeadingRegex = regexp.MustCompile(`(?m)^## Using [Ww]ith GoFor
 
 var markdownHeadingRegex = regexp.MustCompile(`^(#{1,6})\s+(.+?)\s*$`)
 
-func transformReadme(readme string, repo RepoConfig, rawBase string) string {
-	updated := rewriteImageLinks(readme, rawBase)
-	updated = rewriteMarkdownLinks(updated, repo)
+func transformReadme(readme string, repo RepoConfig, rawBase string, meta RepoMetadata, deps libraryDependencies) string {
+	sourceDir := sourceDirOf(repo.ReadmePath)
+	updated := rewriteImageLinks(readme, rawBase, sourceDir)
+	updated = rewriteMarkdownLinks(updated, repo, sourceDir)
+	updated = insertInstallSection(updated, meta)
 	updated = appendFrameworkGuide(updated, repo.FrameworkGuide)
+	updated = appendDependencySections(updated, deps)
 	updated = rewriteHeadingAnchors(updated)
-	return withFrontmatter(repo, updated)
+	return withFrontmatter(repo, meta, updated)
 }
 
 // appendFrameworkGuide keeps framework-specific guidance out of standalone source READMEs while preserving navigation in the docs projection.
@@ -42,13 +45,13 @@ func appendFrameworkGuide(content string, guide FrameworkGuide) string {
 	)
 }
 
-func rewriteImageLinks(content string, rawBase string) string {
+func rewriteImageLinks(content string, rawBase string, sourceDir string) string {
 	withMarkdownImages := markdownImageRegex.ReplaceAllStringFunc(content, func(match string) string {
 		parts := markdownImageRegex.FindStringSubmatch(match)
 		if len(parts) < 2 {
 			return match
 		}
-		return strings.Replace(match, parts[1], rewriteImageURL(parts[1], rawBase), 1)
+		return strings.Replace(match, parts[1], rewriteImageURL(parts[1], rawBase, sourceDir), 1)
 	})
 
 	withHTMLImages := htmlImageRegex.ReplaceAllStringFunc(withMarkdownImages, func(match string) string {
@@ -56,13 +59,13 @@ func rewriteImageLinks(content string, rawBase string) string {
 		if len(parts) < 2 {
 			return match
 		}
-		return strings.Replace(match, parts[1], rewriteImageURL(parts[1], rawBase), 1)
+		return strings.Replace(match, parts[1], rewriteImageURL(parts[1], rawBase, sourceDir), 1)
 	})
 
 	return withHTMLImages
 }
 
-func rewriteImageURL(url string, rawBase string) string {
+func rewriteImageURL(url string, rawBase string, sourceDir string) string {
 	trimmed := strings.TrimSpace(url)
 	lower := strings.ToLower(trimmed)
 	if strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://") || strings.HasPrefix(lower, "data:") {
@@ -72,17 +75,34 @@ func rewriteImageURL(url string, rawBase string) string {
 		return trimmed
 	}
 
-	trimmed = strings.TrimPrefix(trimmed, "./")
-	trimmed = strings.TrimPrefix(trimmed, "/")
-	return rawBase + trimmed
+	return rawBase + repoRelativePath(trimmed, sourceDir)
 }
 
-func rewriteMarkdownLinks(content string, repo RepoConfig) string {
-	base := webGithubBase(repo)
-	branch := repo.Branch
-	if branch == "" {
-		branch = "main"
+// sourceDirOf returns the repository directory a Markdown file lives in so its relative links resolve the way GitHub renders them.
+func sourceDirOf(sourcePath string) string {
+	dir := path.Dir(strings.TrimPrefix(sourcePath, "/"))
+	if dir == "." || dir == "/" {
+		return ""
 	}
+	return dir
+}
+
+// repoRelativePath resolves a relative target against the linking file's directory while leading slashes stay anchored at the repository root.
+func repoRelativePath(target string, sourceDir string) string {
+	if strings.HasPrefix(target, "/") || sourceDir == "" {
+		target = strings.TrimPrefix(target, "./")
+		return strings.TrimPrefix(target, "/")
+	}
+	joined := path.Join(sourceDir, target)
+	if strings.HasSuffix(target, "/") {
+		joined += "/"
+	}
+	return joined
+}
+
+func rewriteMarkdownLinks(content string, repo RepoConfig, sourceDir string) string {
+	links := sourceLinks(repo)
+	branch := repoBranch(repo)
 	lines := strings.Split(content, "\n")
 	inCode := false
 	for i, line := range lines {
@@ -94,24 +114,24 @@ func rewriteMarkdownLinks(content string, repo RepoConfig) string {
 		if inCode {
 			continue
 		}
-		line = rewriteLineLinks(line, base, branch)
-		lines[i] = rewriteHTMLLineLinks(line, base, branch)
+		line = rewriteLineLinks(line, links, branch, sourceDir)
+		lines[i] = rewriteHTMLLineLinks(line, links, branch, sourceDir)
 	}
 	return strings.Join(lines, "\n")
 }
 
 // rewriteHTMLLineLinks rewrites repository-relative anchor targets embedded in raw HTML.
-func rewriteHTMLLineLinks(line string, base string, branch string) string {
+func rewriteHTMLLineLinks(line string, links repoLinks, branch string, sourceDir string) string {
 	return htmlAnchorLinkRegex.ReplaceAllStringFunc(line, func(match string) string {
 		parts := htmlAnchorLinkRegex.FindStringSubmatch(match)
 		if len(parts) != 4 {
 			return match
 		}
-		return parts[1] + rewriteLinkURL(parts[2], base, branch) + parts[3]
+		return parts[1] + rewriteLinkURL(parts[2], links, branch, sourceDir) + parts[3]
 	})
 }
 
-func rewriteLineLinks(line string, base string, branch string) string {
+func rewriteLineLinks(line string, links repoLinks, branch string, sourceDir string) string {
 	var out strings.Builder
 	start := 0
 	for {
@@ -140,14 +160,14 @@ func rewriteLineLinks(line string, base string, branch string) string {
 		closeParen += closeBracket + 2
 		out.WriteString(line[start : closeBracket+2])
 		url := line[closeBracket+2 : closeParen]
-		out.WriteString(rewriteLinkURL(url, base, branch))
+		out.WriteString(rewriteLinkURL(url, links, branch, sourceDir))
 		out.WriteString(")")
 		start = closeParen + 1
 	}
 	return out.String()
 }
 
-func rewriteLinkURL(url string, base string, branch string) string {
+func rewriteLinkURL(url string, links repoLinks, branch string, sourceDir string) string {
 	trimmed := strings.TrimSpace(url)
 	lower := strings.ToLower(trimmed)
 	if strings.HasPrefix(lower, "http://") ||
@@ -166,14 +186,18 @@ func rewriteLinkURL(url string, base string, branch string) string {
 		anchor = trimmed[hashIndex:]
 	}
 
-	pathPart = strings.TrimPrefix(pathPart, "./")
-	pathPart = strings.TrimPrefix(pathPart, "/")
+	if pathPart == "" {
+		return trimmed
+	}
+	pathPart = repoRelativePath(pathPart, sourceDir)
 	if pathPart == "" {
 		return trimmed
 	}
 
-	mode := repositoryLinkMode(pathPart)
-	return base + mode + "/" + branch + "/" + pathPart + anchor
+	if repositoryLinkMode(pathPart) == "tree" {
+		return links.tree(branch, pathPart) + anchor
+	}
+	return links.blob(branch, pathPart) + anchor
 }
 
 // repositoryLinkMode recognizes conventional extensionless repository files because GitHub serves them through its blob route.
@@ -278,27 +302,67 @@ func defaultAnchor(title string) string {
 }
 
 // withFrontmatter suppresses the synthetic search title when imported content already owns that anchor.
-func withFrontmatter(repo RepoConfig, content string) string {
-	title := repo.Title
-	if title == "" {
-		title = repo.Slug
-	}
-	repoURL := strings.TrimSuffix(repo.CloneURL, ".git")
+func withFrontmatter(repo RepoConfig, meta RepoMetadata, content string) string {
+	title := libraryTitle(repo)
+	repoURL := sourceLinks(repo).web()
 	autoTitle := ""
 	if hasHeadingAnchor(content, defaultAnchor(title)) {
 		autoTitle = "noAutoTitle: true\n"
 	}
 	frontmatter := fmt.Sprintf(
-		"---\ntitle: %s\ndescription: %s\nrepoSlug: %s\nrepoUrl: %s\n%s---\n\n",
-		title,
+		"---\ntitle: %s\ndescription: %s\n%srepoSlug: %s\nrepoUrl: %s\n%s%s%s%s---\n\n",
+		strconv.Quote(title),
 		strconv.Quote(repo.Description),
+		presentationFrontmatter(repo),
 		repo.Slug,
 		repoURL,
+		metadataFrontmatter(repo, meta),
+		statsFrontmatter(meta.Stats),
+		translationFrontmatter(repo, meta),
 		autoTitle,
 	)
 	return frontmatter + content
 }
 
+// presentationFrontmatter emits maintainer-supplied search keywords and sidebar label only when docs.yaml provides them.
+func presentationFrontmatter(repo RepoConfig) string {
+	var out strings.Builder
+	if len(repo.Keywords) > 0 {
+		quoted := make([]string, 0, len(repo.Keywords))
+		for _, keyword := range repo.Keywords {
+			quoted = append(quoted, strconv.Quote(keyword))
+		}
+		fmt.Fprintf(&out, "keywords: [%s]\n", strings.Join(quoted, ", "))
+	}
+	if repo.SidebarLabel != "" {
+		fmt.Fprintf(&out, "sidebarLabel: %s\n", strconv.Quote(repo.SidebarLabel))
+	}
+	return out.String()
+}
+
+// metadataFrontmatter quotes every value because YAML would otherwise read Go versions as floats and commit dates as timestamps.
+func metadataFrontmatter(repo RepoConfig, meta RepoMetadata) string {
+	var out strings.Builder
+	for _, field := range []struct {
+		key   string
+		value string
+	}{
+		{key: "modulePath", value: meta.ModulePath},
+		{key: "goVersion", value: meta.GoVersion},
+		{key: "latestTag", value: meta.LatestTag},
+		{key: "sourceCommit", value: meta.CommitSHA},
+		{key: "sourceCommitDate", value: meta.CommitDate},
+		{key: "license", value: meta.License},
+		{key: "editLink", value: editReadmeURL(repo)},
+	} {
+		if field.value == "" {
+			continue
+		}
+		fmt.Fprintf(&out, "%s: %s\n", field.key, strconv.Quote(field.value))
+	}
+	return out.String()
+}
+
 // hasHeadingAnchor detects title ownership after heading IDs have been normalized for VitePress.
 func hasHeadingAnchor(content string, anchor string) bool {
 	if anchor == "" {
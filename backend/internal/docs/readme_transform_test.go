@@ -21,7 +21,7 @@ func TestRewriteMarkdownLinksRewritesRawHTMLAnchors(t *testing.T) {
 		`<a href="mailto:docs@example.com">Email</a>`,
 	}, "\n")
 
-	got := rewriteMarkdownLinks(input, repo)
+	got := rewriteMarkdownLinks(input, repo, "")
 	wants := []string{
 		`href="https://github.com/goforj/godump/blob/main/LICENSE"`,
 		`href='https://github.com/goforj/godump/blob/main/examples/basic/main.go'`,
@@ -51,7 +51,7 @@ func TestRewriteMarkdownLinksPreservesLinksInsideCodeFences(t *testing.T) {
 		"```",
 	}, "\n")
 
-	if got := rewriteMarkdownLinks(input, repo); got != input {
+	if got := rewriteMarkdownLinks(input, repo, ""); got != input {
 		t.Fatalf("rewriteMarkdownLinks() changed fenced content:\n%s", got)
 	}
 }
@@ -72,7 +72,7 @@ func TestTransformReadmeAppendsFrameworkGuide(t *testing.T) {
 		},
 	}
 
-	got := transformReadme("# Queue\n\nStandalone package documentation.\n", repo, "https://raw.githubusercontent.com/goforj/queue/main/")
+	got := transformReadme("# Queue\n\nStandalone package documentation.\n", repo, "https://raw.githubusercontent.com/goforj/queue/main/", RepoMetadata{}, libraryDependencies{})
 	wants := []string{
 		`description: "Queued work with pluggable backend drivers."`,
 		"## Using with GoForj {#using-with-goforj}",
@@ -96,7 +96,7 @@ func TestTransformReadmeQuotesFrontmatterDescription(t *testing.T) {
 		Branch:      "main",
 	}
 
-	got := transformReadme("# Cache\n", repo, "https://raw.githubusercontent.com/goforj/cache/main/")
+	got := transformReadme("# Cache\n", repo, "https://raw.githubusercontent.com/goforj/cache/main/", RepoMetadata{}, libraryDependencies{})
 	if !strings.Contains(got, `description: "Cache helpers: local, distributed, and \"typed\"."`) {
 		t.Fatalf("transformReadme() did not quote the description safely:\n%s", got)
 	}
@@ -153,7 +153,7 @@ func TestTransformReadmePreservesConsoleAPIExampleLinks(t *testing.T) {
 		`#### <a id="marks"></a>Marks`,
 	}, "\n")
 
-	got := transformReadme(input, repo, "https://raw.githubusercontent.com/goforj/console/main/")
+	got := transformReadme(input, repo, "https://raw.githubusercontent.com/goforj/console/main/", RepoMetadata{}, libraryDependencies{})
 	for _, want := range []string{
 		"noAutoTitle: true",
 		"[Loader.Start](#loader-start) · [Progress](#progress) · [Console](#console) · [Marks](#marks)",
@@ -179,7 +179,7 @@ func TestTransformReadmeKeepsAutomaticSearchTitleWhenUnclaimed(t *testing.T) {
 		Branch:   "main",
 	}
 
-	got := transformReadme("## Usage\n", repo, "https://raw.githubusercontent.com/goforj/str/main/")
+	got := transformReadme("## Usage\n", repo, "https://raw.githubusercontent.com/goforj/str/main/", RepoMetadata{}, libraryDependencies{})
 	if strings.Contains(got, "noAutoTitle: true") {
 		t.Fatalf("transformReadme() disabled the unclaimed automatic title:\n%s", got)
 	}
@@ -194,8 +194,76 @@ func TestTransformReadmeSuppressesDuplicateSearchTitle(t *testing.T) {
 		Branch:   "main",
 	}
 
-	got := transformReadme(`#### <a id="queue"></a>Queue`, repo, "https://raw.githubusercontent.com/goforj/queue/main/")
+	got := transformReadme(`#### <a id="queue"></a>Queue`, repo, "https://raw.githubusercontent.com/goforj/queue/main/", RepoMetadata{}, libraryDependencies{})
 	if !strings.Contains(got, "noAutoTitle: true") {
 		t.Fatalf("transformReadme() kept a conflicting automatic title:\n%s", got)
 	}
 }
+
+// TestTransformReadmeWritesRepositoryMetadata verifies checkout facts reach the frontmatter as quoted YAML strings.
+func TestTransformReadmeWritesRepositoryMetadata(t *testing.T) {
+	repo := RepoConfig{
+		Slug:     "cache",
+		Title:    "Cache",
+		CloneURL: "https://github.com/goforj/cache.git",
+		Branch:   "main",
+	}
+	meta := RepoMetadata{
+		ModulePath: "github.com/goforj/cache",
+		GoVersion:  "1.20",
+		LatestTag:  "v1.4.0",
+		CommitSHA:  "5ab5e9cbabd399576026d337f50b9df62c00aa8e",
+		CommitDate: "2026-08-01T10:00:00-05:00",
+		License:    "MIT",
+	}
+
+	got := transformReadme("# Cache\n", repo, "https://raw.githubusercontent.com/goforj/cache/main/", meta, libraryDependencies{})
+	for _, want := range []string{
+		`modulePath: "github.com/goforj/cache"`,
+		`goVersion: "1.20"`,
+		`latestTag: "v1.4.0"`,
+		`sourceCommit: "5ab5e9cbabd399576026d337f50b9df62c00aa8e"`,
+		`sourceCommitDate: "2026-08-01T10:00:00-05:00"`,
+		`license: "MIT"`,
+		`editLink: "https://github.com/goforj/cache/edit/main/README.md"`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("transformReadme() missing %q in:\n%s", want, got)
+		}
+	}
+}
+
+// TestTransformReadmeOmitsUnknownMetadata verifies local sources without git or module data do not emit empty keys.
+func TestTransformReadmeOmitsUnknownMetadata(t *testing.T) {
+	repo := RepoConfig{
+		Slug:     "str",
+		Title:    "Strings",
+		CloneURL: "https://github.com/goforj/str.git",
+		Branch:   "main",
+	}
+
+	got := transformReadme("# Strings\n", repo, "https://raw.githubusercontent.com/goforj/str/main/", RepoMetadata{}, libraryDependencies{})
+	for _, unwanted := range []string{"modulePath:", "goVersion:", "latestTag:", "sourceCommit:", "license:"} {
+		if strings.Contains(got, unwanted) {
+			t.Fatalf("transformReadme() wrote empty %q in:\n%s", unwanted, got)
+		}
+	}
+}
+
+// TestTransformReadmeAnchorsGeneratedInstallSection verifies the injected heading takes part in anchor de-duplication.
+func TestTransformReadmeAnchorsGeneratedInstallSection(t *testing.T) {
+	repo := RepoConfig{
+		Slug:     "atlas",
+		Title:    "Atlas",
+		CloneURL: "https://github.com/goforj/atlas.git",
+		Branch:   "main",
+	}
+	meta := RepoMetadata{ModulePath: "github.com/goforj/atlas", LatestTag: "v0.3.0"}
+
+	got := transformReadme("# Atlas\n\n### Setup {#install}\n", repo, "https://raw.githubusercontent.com/goforj/atlas/main/", meta, libraryDependencies{})
+	for _, want := range []string{"## Install {#install-2}", "### Setup {#install}"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("transformReadme() missing %q in:\n%s", want, got)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package docs
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+const redirectsPath = ".vitepress/data/redirects.json"
+
+// pageRedirect sends a removed page to its canonical replacement. Fragments map old heading IDs to new ones so
+// published deep links keep landing on the right section.
+type pageRedirect struct {
+	To        string            `json:"to"`
+	Fragments map[string]string `json:"fragments"`
+}
+
+// pageRedirects is docs/.vitepress/data/redirects.json keyed by the removed route without a leading slash, the same
+// table the VitePress build turns into redirect pages.
+type pageRedirects struct {
+	Entries map[string]pageRedirect
+	lines   []string
+}
+
+func loadPageRedirects(file string) (pageRedirects, error) {
+	redirects := pageRedirects{}
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return redirects, fmt.Errorf("read %s: %w", path.Base(file), err)
+	}
+	if err := json.Unmarshal(content, &redirects.Entries); err != nil {
+		return redirects, fmt.Errorf("parse %s: %w", path.Base(file), err)
+	}
+	redirects.lines = strings.Split(string(content), "\n")
+	return redirects, nil
+}
+
+// sources lists the redirected routes in a stable order for reporting.
+func (redirects pageRedirects) sources() []string {
+	sources := make([]string, 0, len(redirects.Entries))
+	for from := range redirects.Entries {
+		sources = append(sources, from)
+	}
+	sort.Strings(sources)
+	return sources
+}
+
+// lineOf finds the line declaring a redirect so problems point at the entry to edit.
+func (redirects pageRedirects) lineOf(from string) int {
+	quoted := fmt.Sprintf("%q:", from)
+	for i, line := range redirects.lines {
+		if strings.Contains(line, quoted) {
+			return i + 1
+		}
+	}
+	return 1
+}
+
+// checkRedirects verifies every target page and fragment exists in the markdown tree, that no redirect shadows a
+// page that still exists, and that no redirect lands on another redirect. Chains are reported with their full path
+// so the entry can point at the final page directly; loops would never resolve.
+func checkRedirects(site docsSite, redirects pageRedirects, docsDir string) []string {
+	location := func(from string) string {
+		return fmt.Sprintf("%s:%d", path.Join(docsDir, redirectsPath), redirects.lineOf(from))
+	}
+	byRoute := map[string]string{}
+	for from := range redirects.Entries {
+		byRoute[normalizeRoute(from)] = from
+	}
+
+	var problems []string
+	for _, from := range redirects.sources() {
+		redirect := redirects.Entries[from]
+		if page, ok := site.Pages[normalizeRoute(from)]; ok {
+			problems = append(problems, fmt.Sprintf("%s: /%s still exists as %s; the build would replace it with a redirect", location(from), from, page.File))
+		}
+		if !strings.HasPrefix(redirect.To, "/") || strings.Contains(redirect.To, "#") {
+			problems = append(problems, fmt.Sprintf("%s: /%s target %q must be an absolute route without a fragment", location(from), from, redirect.To))
+			continue
+		}
+
+		chain := redirectChain(from, byRoute, redirects.Entries)
+		last := chain[len(chain)-1]
+		if _, loops := byRoute[normalizeRoute(last)]; loops {
+			problems = append(problems, fmt.Sprintf("%s: redirect loop %s", location(from), strings.Join(chain, " -> ")))
+			continue
+		}
+		if len(chain) > 2 {
+			problems = append(problems, fmt.Sprintf("%s: redirect chain %s; point /%s at %s directly", location(from), strings.Join(chain, " -> "), from, last))
+			continue
+		}
+
+		page, ok := site.Pages[normalizeRoute(redirect.To)]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s: /%s redirects to %s, which is not a page", location(from), from, redirect.To))
+			continue
+		}
+		targets := make([]string, 0, len(redirect.Fragments))
+		for _, fragment := range redirect.Fragments {
+			targets = append(targets, fragment)
+		}
+		sort.Strings(targets)
+		for i, fragment := range targets {
+			if i > 0 && targets[i-1] == fragment {
+				continue
+			}
+			if _, ok := page.Anchors[fragment]; !ok {
+				problems = append(problems, fmt.Sprintf("%s: /%s redirects to %s#%s, but %s has no such heading", location(from), from, redirect.To, fragment, page.File))
+			}
+		}
+	}
+	return problems
+}
+
+// redirectChain follows targets that are themselves redirected. It returns the routes visited, ending at the first
+// non-redirected target or at the first route seen twice.
+func redirectChain(from string, byRoute map[string]string, entries map[string]pageRedirect) []string {
+	chain := []string{"/" + from}
+	seen := map[string]struct{}{normalizeRoute(from): {}}
+	current := from
+	for {
+		target := entries[current].To
+		chain = append(chain, target)
+		next, redirected := byRoute[normalizeRoute(target)]
+		if !redirected {
+			return chain
+		}
+		if _, ok := seen[normalizeRoute(target)]; ok {
+			return chain
+		}
+		seen[normalizeRoute(target)] = struct{}{}
+		current = next
+	}
+}
@@ -0,0 +1,61 @@
+package docs
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/goforj/docs/internal/logger"
+)
+
+// RedirectsCommand lists the redirects for removed pages, or checks them against the markdown tree.
+type RedirectsCommand struct {
+	Output    string `name:"output" type:"path" env:"DOCS_OUTPUT_DIR" help:"Docs root holding the pages and redirect table (defaults to ./docs or ../docs)"`
+	Redirects string `name:"redirects" type:"path" help:"Redirect table (defaults to .vitepress/data/redirects.json under the docs root)"`
+	Check     bool   `name:"check" help:"Verify every target page and fragment exists and no redirect chains or loops"`
+	logger    *logger.AppLogger
+}
+
+// NewDocsRedirectsCommand creates a new RedirectsCommand.
+func NewDocsRedirectsCommand(logger *logger.AppLogger) *RedirectsCommand {
+	return &RedirectsCommand{
+		logger: logger,
+	}
+}
+
+// Run prints one line per redirect, or with --check prints each problem as path:line and fails when any exist.
+func (c *RedirectsCommand) Run() error {
+	docsRoot, err := resolveDocsRoot(c.Output)
+	if err != nil {
+		return err
+	}
+	redirectsFile := c.Redirects
+	if redirectsFile == "" {
+		redirectsFile = filepath.Join(docsRoot, filepath.FromSlash(redirectsPath))
+	}
+	redirects, err := loadPageRedirects(redirectsFile)
+	if err != nil {
+		return err
+	}
+
+	if !c.Check {
+		for _, from := range redirects.sources() {
+			redirect := redirects.Entries[from]
+			fmt.Printf("/%s -> %s (%d fragment(s))\n", from, redirect.To, len(redirect.Fragments))
+		}
+		return nil
+	}
+
+	site, err := loadDocsSite(docsRoot, libraryRewriteMap(defaultRepos()))
+	if err != nil {
+		return err
+	}
+	problems := checkRedirects(site, redirects, filepath.Base(docsRoot))
+	for _, problem := range problems {
+		fmt.Println(problem)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("found %d redirect problem(s)", len(problems))
+	}
+	c.logger.Info().Any("redirects", len(redirects.Entries)).Msg("All redirects resolve")
+	return nil
+}
@@ -0,0 +1,72 @@
+package docs
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestCheckRedirectsFindsMissingTargetsChainsAndLoops verifies targets and fragments are resolved against the markdown
+// tree and that redirects landing on other redirects are reported with their path.
+func TestCheckRedirectsFindsMissingTargetsChainsAndLoops(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	writeTestFiles(t, root, map[string]string{
+		"testing/index.md":   "# Testing\n\n## Choose a Test Layer\n",
+		"core/apps.md":       "# Apps\n\n## The Default App\n",
+		"core/still-here.md": "# Still Here\n",
+		".vitepress/data/redirects.json": `{
+  "testing/overview": {
+    "to": "/testing/",
+    "fragments": { "layers": "choose-a-test-layer", "old": "renamed-heading" }
+  },
+  "core/app": {
+    "to": "/core/apps",
+    "fragments": { "default-app": "the-default-app" }
+  },
+  "core/app-old": {
+    "to": "/core/app",
+    "fragments": {}
+  },
+  "core/missing": {
+    "to": "/core/gone",
+    "fragments": {}
+  },
+  "core/still-here": {
+    "to": "/core/apps",
+    "fragments": {}
+  },
+  "data/a": {
+    "to": "/data/b",
+    "fragments": {}
+  },
+  "data/b": {
+    "to": "/data/a",
+    "fragments": {}
+  }
+}
+`,
+	})
+	site, err := loadDocsSite(root, nil)
+	if err != nil {
+		t.Fatalf("loadDocsSite() error = %v", err)
+	}
+	redirects, err := loadPageRedirects(filepath.Join(root, filepath.FromSlash(redirectsPath)))
+	if err != nil {
+		t.Fatalf("loadPageRedirects() error = %v", err)
+	}
+
+	got := strings.Join(checkRedirects(site, redirects, "docs"), "\n")
+	want := strings.Join([]string{
+		"docs/.vitepress/data/redirects.json:10: redirect chain /core/app-old -> /core/app -> /core/apps; point /core/app-old at /core/apps directly",
+		"docs/.vitepress/data/redirects.json:14: /core/missing redirects to /core/gone, which is not a page",
+		"docs/.vitepress/data/redirects.json:18: /core/still-here still exists as core/still-here.md; the build would replace it with a redirect",
+		"docs/.vitepress/data/redirects.json:22: redirect loop /data/a -> /data/b -> /data/a",
+		"docs/.vitepress/data/redirects.json:26: redirect loop /data/b -> /data/a -> /data/b",
+		"docs/.vitepress/data/redirects.json:2: /testing/overview redirects to /testing/#renamed-heading, but testing/index.md has no such heading",
+	}, "\n")
+	if got != want {
+		t.Fatalf("checkRedirects() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,226 @@
+package docs
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// defaultRepos is the central library registry; every docs command reads the same list so page paths and checkouts stay aligned.
+func defaultRepos() []RepoConfig {
+	return []RepoConfig{
+		{
+			Slug:        "collection",
+			Title:       "Collections",
+			Description: "Fluent, typed collection operations for Go with explicit mutation behavior.",
+			CloneURL:    "https://github.com/goforj/collection.git",
+			Branch:      "main",
+			OutputPath:  filepath.Join("libraries", "collection.md"),
+		},
+		{
+			Slug:        "str",
+			Title:       "Strings",
+			Description: "Rune-safe string construction, matching, transformation, and inflection helpers.",
+			CloneURL:    "https://github.com/goforj/str.git",
+			Branch:      "main",
+			OutputPath:  filepath.Join("libraries", "strings.md"),
+		},
+		{
+			Slug:        "httpx",
+			Title:       "HTTPX",
+			Description: "HTTP client helpers for typed requests, authentication, retries, and diagnostics.",
+			CloneURL:    "https://github.com/goforj/httpx.git",
+			Branch:      "main",
+			OutputPath:  filepath.Join("libraries", "httpx.md"),
+		},
+		{
+			Slug:        "web",
+			Title:       "Web",
+			Description: "Server-side HTTP contracts, routing, middleware, testing, and an Echo-backed runtime.",
+			CloneURL:    "https://github.com/goforj/web.git",
+			Branch:      "main",
+			OutputPath:  filepath.Join("libraries", "web.md"),
+			FrameworkGuide: FrameworkGuide{
+				Title:   "HTTP Services",
+				Path:    "/applications/http-services",
+				Summary: "GoForj Apps register web routes and controllers through the HTTP runtime. Keep server wiring in framework providers and inject application services into controllers.",
+			},
+		},
+		{
+			Slug:        "execx",
+			Title:       "ExecX",
+			Description: "Command execution helpers with streaming, decoding, and TTY support.",
+			CloneURL:    "https://github.com/goforj/execx.git",
+			Branch:      "main",
+			OutputPath:  filepath.Join("libraries", "execx.md"),
+		},
+		{
+			Slug:        "console",
+			Title:       "Console",
+			Description: "Semantic CLI output, ANSI-aware layout, prompts, loaders, and progress.",
+			CloneURL:    "https://github.com/goforj/console.git",
+			Branch:      "main",
+			OutputPath:  filepath.Join("libraries", "console.md"),
+		},
+		{
+			Slug:        "godump",
+			Title:       "GoDump",
+			Description: "Readable, configurable value dumps for debugging Go programs.",
+			CloneURL:    "https://github.com/goforj/godump.git",
+			Branch:      "main",
+			OutputPath:  filepath.Join("libraries", "godump.md"),
+		},
+		{
+			Slug:        "env",
+			Title:       "Env",
+			Description: "Layered environment loading and typed configuration helpers for Go.",
+			CloneURL:    "https://github.com/goforj/env.git",
+			Branch:      "main",
+			OutputPath:  filepath.Join("libraries", "env.md"),
+		},
+		{
+			Slug:        "scheduler",
+			Title:       "Scheduler",
+			Description: "Recurring work primitives with cron, intervals, overlap protection, and runtime controls.",
+			CloneURL:    "https://github.com/goforj/scheduler.git",
+			Branch:      "main",
+			OutputPath:  filepath.Join("libraries", "scheduler.md"),
+			FrameworkGuide: FrameworkGuide{
+				Title:   "Scheduler",
+				Path:    "/async/scheduler",
+				Summary: "GoForj Apps register schedules in the scheduler runtime and inject the jobs they run. Keep recurring business work in jobs instead of the schedule registry.",
+			},
+		},
+		{
+			Slug:        "queue",
+			Title:       "Queue",
+			Description: "Queued work, workers, retries, workflows, and pluggable backend drivers.",
+			CloneURL:    "https://github.com/goforj/queue.git",
+			Branch:      "main",
+			OutputPath:  filepath.Join("libraries", "queue.md"),
+			Drivers:     &DriverSpec{Type: "Driver", Suffix: "queue"},
+			FrameworkGuide: FrameworkGuide{
+				Title:   "Queues",
+				Path:    "/async/queues",
+				Summary: "GoForj Apps expose named queues through generated accessors. Dispatch jobs through those accessors and keep backend selection in queue configuration.",
+			},
+		},
+		{
+			Slug:        "events",
+			Title:       "Events",
+			Description: "Typed event publication and subscription with local and distributed transports.",
+			CloneURL:    "https://github.com/goforj/events.git",
+			Branch:      "main",
+			OutputPath:  filepath.Join("libraries", "events.md"),
+			Drivers: &DriverSpec{
+				Package: "eventscore",
+				Type:    "Driver",
+				Suffix:  "events",
+				Aliases: map[string]string{"natsjetstream": "jetstream"},
+			},
+			FrameworkGuide: FrameworkGuide{
+				Title:   "Events",
+				Path:    "/async/events",
+				Summary: "GoForj Apps expose named event buses through generated accessors. Publish through those accessors and keep driver selection in event configuration.",
+			},
+		},
+		{
+			Slug:        "mail",
+			Title:       "Mail",
+			Description: "Portable message composition with local, SMTP, and provider delivery drivers.",
+			CloneURL:    "https://github.com/goforj/mail.git",
+			Branch:      "main",
+			OutputPath:  filepath.Join("libraries", "mail.md"),
+			Drivers:     &DriverSpec{Type: "Driver", Prefix: "mail"},
+			FrameworkGuide: FrameworkGuide{
+				Title:   "Mail",
+				Path:    "/applications/mail",
+				Summary: "GoForj Apps expose named mailers through generated accessors. Send through those accessors and keep transport selection and credentials in configuration.",
+			},
+		},
+		{
+			Slug:        "cache",
+			Title:       "Cache",
+			Description: "One cache API with local, distributed, and database-backed stores.",
+			CloneURL:    "https://github.com/goforj/cache.git",
+			Branch:      "main",
+			OutputPath:  filepath.Join("libraries", "cache.md"),
+			Drivers:     &DriverSpec{Type: "Driver", Suffix: "cache"},
+			FrameworkGuide: FrameworkGuide{
+				Title:   "Cache Patterns",
+				Path:    "/data/cache-patterns",
+				Summary: "GoForj Apps expose named caches through generated accessors. Use those accessors in application services and keep backend selection in cache configuration.",
+			},
+		},
+		{
+			Slug:        "crypt",
+			Title:       "Crypt",
+			Description: "Encryption helpers with key generation and rotation support.",
+			CloneURL:    "https://github.com/goforj/crypt.git",
+			Branch:      "main",
+			OutputPath:  filepath.Join("libraries", "crypt.md"),
+		},
+		{
+			Slug:        "storage",
+			Title:       "Storage",
+			Description: "Named file and object-storage disks with local and remote drivers.",
+			CloneURL:    "https://github.com/goforj/storage.git",
+			Branch:      "main",
+			OutputPath:  filepath.Join("libraries", "storage.md"),
+			Drivers:     &DriverSpec{Type: "Driver", Suffix: "storage"},
+			FrameworkGuide: FrameworkGuide{
+				Title:   "Storage Patterns",
+				Path:    "/data/storage-patterns",
+				Summary: "GoForj Apps expose named disks through generated accessors. Use those accessors in application services and keep backend selection in storage configuration.",
+			},
+		},
+		{
+			Slug:        "metrics",
+			Title:       "Metrics",
+			Description: "Counters, gauges, histograms, snapshots, and Prometheus-compatible export.",
+			CloneURL:    "https://github.com/goforj/metrics.git",
+			Branch:      "main",
+			OutputPath:  filepath.Join("libraries", "metrics.md"),
+			FrameworkGuide: FrameworkGuide{
+				Title:   "Metrics",
+				Path:    "/operations/metrics",
+				Summary: "GoForj Apps expose metrics through the observability and HTTP runtime. Keep registration close to the behavior being measured and configure scrape exposure through the App runtime.",
+			},
+		},
+		{
+			Slug:        "wire",
+			Title:       "Wire",
+			Description: "Fast, explicit compile-time dependency injection for Go.",
+			CloneURL:    "https://github.com/goforj/wire.git",
+			Branch:      "main",
+			OutputPath:  filepath.Join("libraries", "wire.md"),
+			ReadmePath:  "README.md",
+			RepoName:    "wire",
+		},
+		{
+			Slug:        "atlas",
+			Title:       "Atlas",
+			Description: "Project context, skills, diagnostics, and MCP tooling for GoForj coding agents.",
+			CloneURL:    "https://github.com/goforj/atlas.git",
+			Branch:      "main",
+			OutputPath:  filepath.Join("libraries", "atlas.md"),
+		},
+	}
+}
+
+// translationLanguages lists the README locales docs:generate publishes; README.<lang>.md files for other languages are ignored.
+func translationLanguages() []string {
+	return []string{"zh-CN", "es"}
+}
+
+// selectRepos narrows the registry to one slug so single-library runs fail loudly on typos instead of generating nothing.
+func selectRepos(repos []RepoConfig, slug string) ([]RepoConfig, error) {
+	if slug == "" {
+		return repos, nil
+	}
+	for _, repo := range repos {
+		if repo.Slug == slug {
+			return []RepoConfig{repo}, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown repo %q", slug)
+}
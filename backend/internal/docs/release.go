@@ -0,0 +1,249 @@
+package docs
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/semver"
+)
+
+const (
+	releaseDataPath      = ".vitepress/data/release.json"
+	releaseChangelogPath = "versions/changelog.md"
+	releaseVersionsPath  = "versions/index.md"
+	releaseConfigPath    = ".vitepress/config.mts"
+	releaseDateLayout    = "January 2, 2006"
+)
+
+var releaseVersionRegex = regexp.MustCompile(`^v\d+\.\d+\.\d+$`)
+var releaseHeadingRegex = regexp.MustCompile(`^## (v\d+\.\d+\.\d+)\s*$`)
+var releasedLineRegex = regexp.MustCompile(`^Released [A-Z][a-z]+ \d{1,2}, \d{4}\.$`)
+var releaseLiteralRegex = regexp.MustCompile(`\bv\d+\.\d+\.\d+\b`)
+var currentVersionClaimRegex = regexp.MustCompile(`(?i)\b(?:current|latest)\b[^.]*?\b(v\d+\.\d+\.\d+)\b`)
+
+// releaseData is docs/.vitepress/data/release.json, the single source for the current framework version label.
+type releaseData struct {
+	Latest string `json:"latest"`
+}
+
+func loadReleaseData(docsRoot string) (releaseData, error) {
+	var data releaseData
+	content, err := os.ReadFile(filepath.Join(docsRoot, filepath.FromSlash(releaseDataPath)))
+	if err != nil {
+		return data, fmt.Errorf("read %s: %w", releaseDataPath, err)
+	}
+	if err := json.Unmarshal(content, &data); err != nil {
+		return data, fmt.Errorf("parse %s: %w", releaseDataPath, err)
+	}
+	return data, nil
+}
+
+func renderReleaseData(data releaseData) (string, error) {
+	content, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(content) + "\n", nil
+}
+
+// cutChangelogRelease opens a "## <version>" section directly below Unreleased and moves Unreleased entries into it.
+// The Unreleased intro paragraph stays; entries start at the first subsection heading or list item.
+func cutChangelogRelease(changelog string, version string, date time.Time) (string, error) {
+	lines := strings.Split(changelog, "\n")
+	start := -1
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "## Unreleased" {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return "", fmt.Errorf("%s has no ## Unreleased section", releaseChangelogPath)
+	}
+	end := len(lines)
+	for i := start + 1; i < len(lines); i++ {
+		if strings.HasPrefix(lines[i], "## ") {
+			end = i
+			break
+		}
+		if matches := releaseHeadingRegex.FindStringSubmatch(lines[i]); len(matches) == 2 && matches[1] == version {
+			return "", fmt.Errorf("%s already has a %s section", releaseChangelogPath, version)
+		}
+	}
+	for _, line := range lines[end:] {
+		if matches := releaseHeadingRegex.FindStringSubmatch(line); len(matches) == 2 && matches[1] == version {
+			return "", fmt.Errorf("%s already has a %s section", releaseChangelogPath, version)
+		}
+	}
+
+	entries := -1
+	for i := start + 1; i < end; i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(trimmed, "### ") || strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* ") {
+			entries = i
+			break
+		}
+	}
+	if entries < 0 {
+		return "", fmt.Errorf("%s has no Unreleased entries to release", releaseChangelogPath)
+	}
+
+	intro := trimBlankLines(lines[start+1 : entries])
+	moved := trimBlankLines(lines[entries:end])
+	var out []string
+	out = append(out, lines[:start+1]...)
+	out = append(out, "")
+	if len(intro) > 0 {
+		out = append(out, intro...)
+		out = append(out, "")
+	}
+	out = append(out, "## "+version, "", fmt.Sprintf("Released %s.", date.Format(releaseDateLayout)), "")
+	out = append(out, moved...)
+	out = append(out, "")
+	out = append(out, lines[end:]...)
+	return strings.Join(out, "\n"), nil
+}
+
+func trimBlankLines(lines []string) []string {
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// checkReleaseConsistency verifies release.json, the changelog, /versions/, the nav and active guidance agree on the
+// current version. Problems are path:line: message relative to the repository root.
+func checkReleaseConsistency(repoRoot string, docsRoot string, activeGuides []string) ([]string, error) {
+	docsDir := filepath.Base(docsRoot)
+	location := func(rel string, line int) string {
+		return fmt.Sprintf("%s:%d", path.Join(docsDir, rel), line)
+	}
+	var problems []string
+
+	data, err := loadReleaseData(docsRoot)
+	if err != nil {
+		return nil, err
+	}
+	latest := data.Latest
+	if !releaseVersionRegex.MatchString(latest) {
+		return []string{fmt.Sprintf("%s: latest %q must look like vX.Y.Z", location(releaseDataPath, 1), latest)}, nil
+	}
+
+	changelog, err := os.ReadFile(filepath.Join(docsRoot, filepath.FromSlash(releaseChangelogPath)))
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", releaseChangelogPath, err)
+	}
+	problems = append(problems, checkChangelogRelease(string(changelog), latest, location)...)
+
+	versions, err := os.ReadFile(filepath.Join(docsRoot, filepath.FromSlash(releaseVersionsPath)))
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", releaseVersionsPath, err)
+	}
+	if !strings.Contains(string(versions), "`%%LATEST_RELEASE%%`") || !strings.Contains(string(versions), "#%%LATEST_RELEASE_ANCHOR%%") {
+		problems = append(problems, fmt.Sprintf("%s: the current version must come from %%%%LATEST_RELEASE%%%% tokens filled from release.json", location(releaseVersionsPath, 1)))
+	}
+	for i, line := range strings.Split(string(versions), "\n") {
+		if literal := releaseLiteralRegex.FindString(line); literal != "" {
+			problems = append(problems, fmt.Sprintf("%s: hard-coded %s; use %%%%LATEST_RELEASE%%%% so it follows release.json", location(releaseVersionsPath, i+1), literal))
+		}
+	}
+
+	config, err := os.ReadFile(filepath.Join(docsRoot, filepath.FromSlash(releaseConfigPath)))
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", releaseConfigPath, err)
+	}
+	if !strings.Contains(string(config), "import release from './data/release.json'") || !strings.Contains(string(config), "Latest tag ${release.latest}") {
+		problems = append(problems, fmt.Sprintf("%s: the versions nav must label the latest tag from release.json", location(releaseConfigPath, 1)))
+	}
+	for i, line := range strings.Split(string(config), "\n") {
+		if strings.Contains(line, "Latest tag v") {
+			problems = append(problems, fmt.Sprintf("%s: hard-coded latest tag in the nav; use ${release.latest}", location(releaseConfigPath, i+1)))
+		}
+	}
+
+	for _, guide := range activeGuides {
+		content, err := os.ReadFile(filepath.Join(repoRoot, filepath.FromSlash(guide)))
+		if err != nil {
+			continue
+		}
+		for i, line := range strings.Split(string(content), "\n") {
+			for _, match := range currentVersionClaimRegex.FindAllStringSubmatch(line, -1) {
+				if match[1] != latest {
+					problems = append(problems, fmt.Sprintf("%s:%d: names %s as current but release.json says %s", guide, i+1, match[1], latest))
+				}
+			}
+		}
+	}
+	return problems, nil
+}
+
+// checkChangelogRelease requires the newest version section to be the latest release, directly below Unreleased.
+func checkChangelogRelease(changelog string, latest string, location func(string, int) string) []string {
+	var problems []string
+	lines := strings.Split(changelog, "\n")
+	unreleased, first, seen := 0, 0, map[string]int{}
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "## Unreleased" && unreleased == 0 {
+			unreleased = i + 1
+			continue
+		}
+		matches := releaseHeadingRegex.FindStringSubmatch(line)
+		if len(matches) != 2 {
+			continue
+		}
+		if previous, ok := seen[matches[1]]; ok {
+			problems = append(problems, fmt.Sprintf("%s: duplicate %s section; first at line %d", location(releaseChangelogPath, i+1), matches[1], previous))
+			continue
+		}
+		seen[matches[1]] = i + 1
+		if first == 0 {
+			first = i + 1
+			if matches[1] != latest {
+				problems = append(problems, fmt.Sprintf("%s: newest changelog section is %s but release.json says %s", location(releaseChangelogPath, i+1), matches[1], latest))
+			}
+			if !releasedOn(lines, i) {
+				problems = append(problems, fmt.Sprintf("%s: %s needs a \"Released Month D, YYYY.\" line", location(releaseChangelogPath, i+1), matches[1]))
+			}
+		}
+	}
+	if unreleased == 0 {
+		problems = append(problems, fmt.Sprintf("%s: missing ## Unreleased section", location(releaseChangelogPath, 1)))
+	} else if first != 0 && first < unreleased {
+		problems = append(problems, fmt.Sprintf("%s: ## Unreleased must come before released versions", location(releaseChangelogPath, unreleased)))
+	}
+	if _, ok := seen[latest]; !ok {
+		problems = append(problems, fmt.Sprintf("%s: no ## %s section for the release in release.json", location(releaseChangelogPath, 1), latest))
+	}
+	return problems
+}
+
+func releasedOn(lines []string, heading int) bool {
+	for i := heading + 1; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if trimmed == "" {
+			continue
+		}
+		return releasedLineRegex.MatchString(trimmed)
+	}
+	return false
+}
+
+// validateNextRelease accepts only well-formed versions newer than the current label.
+func validateNextRelease(current string, next string) error {
+	if !releaseVersionRegex.MatchString(next) {
+		return fmt.Errorf("release version %q must look like vX.Y.Z", next)
+	}
+	if semver.IsValid(current) && semver.Compare(next, current) <= 0 {
+		return fmt.Errorf("release version %s must be newer than %s", next, current)
+	}
+	return nil
+}
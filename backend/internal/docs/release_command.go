@@ -0,0 +1,97 @@
+package docs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/goforj/docs/internal/logger"
+)
+
+// ReleaseCommand records a framework release in release.json and the changelog, or checks they agree.
+type ReleaseCommand struct {
+	Version string `arg:"" optional:"" name:"version" help:"Framework release to record, e.g. v0.25.0"`
+	Output  string `name:"output" type:"path" env:"DOCS_OUTPUT_DIR" help:"Docs root holding release.json and the changelog (defaults to ./docs or ../docs)"`
+	Date    string `name:"date" help:"Release date as YYYY-MM-DD (defaults to today)"`
+	Check   bool   `name:"check" help:"Verify release.json, the nav, /versions/ and the changelog agree instead of writing"`
+	logger  *logger.AppLogger
+}
+
+// NewDocsReleaseCommand creates a new ReleaseCommand.
+func NewDocsReleaseCommand(logger *logger.AppLogger) *ReleaseCommand {
+	return &ReleaseCommand{
+		logger: logger,
+	}
+}
+
+// Run moves Unreleased changelog entries under the new version and updates release.json, or checks consistency.
+func (c *ReleaseCommand) Run() error {
+	docsRoot, err := resolveDocsRoot(c.Output)
+	if err != nil {
+		return err
+	}
+	repoRoot := filepath.Dir(docsRoot)
+
+	if c.Check {
+		if c.Version != "" {
+			return fmt.Errorf("--check verifies the recorded release; omit the version")
+		}
+		var active []string
+		if manifest, err := loadGovernanceManifest(filepath.Join(repoRoot, filepath.FromSlash(governancePath))); err == nil {
+			active = manifest.Active
+		}
+		problems, err := checkReleaseConsistency(repoRoot, docsRoot, active)
+		if err != nil {
+			return err
+		}
+		for _, problem := range problems {
+			fmt.Println(problem)
+		}
+		if len(problems) > 0 {
+			return fmt.Errorf("release metadata disagrees in %d place(s)", len(problems))
+		}
+		c.logger.Info().Msg("Release metadata is consistent")
+		return nil
+	}
+
+	if c.Version == "" {
+		return fmt.Errorf("pass the release version, e.g. docs:release v0.25.0, or --check")
+	}
+	date := time.Now()
+	if c.Date != "" {
+		date, err = time.Parse(time.DateOnly, c.Date)
+		if err != nil {
+			return fmt.Errorf("parse --date: %w", err)
+		}
+	}
+	data, err := loadReleaseData(docsRoot)
+	if err != nil {
+		return err
+	}
+	if err := validateNextRelease(data.Latest, c.Version); err != nil {
+		return err
+	}
+
+	changelogPath := filepath.Join(docsRoot, filepath.FromSlash(releaseChangelogPath))
+	changelog, err := os.ReadFile(changelogPath)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", releaseChangelogPath, err)
+	}
+	updated, err := cutChangelogRelease(string(changelog), c.Version, date)
+	if err != nil {
+		return err
+	}
+	rendered, err := renderReleaseData(releaseData{Latest: c.Version})
+	if err != nil {
+		return err
+	}
+	if err := writeGeneratedPage(changelogPath, updated); err != nil {
+		return fmt.Errorf("write %s: %w", releaseChangelogPath, err)
+	}
+	if err := writeGeneratedPage(filepath.Join(docsRoot, filepath.FromSlash(releaseDataPath)), rendered); err != nil {
+		return fmt.Errorf("write %s: %w", releaseDataPath, err)
+	}
+	c.logger.Info().Any("version", c.Version).Any("previous", data.Latest).Msg("Recorded framework release")
+	return nil
+}
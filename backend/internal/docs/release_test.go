@@ -0,0 +1,58 @@
+package docs
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestCutChangelogReleaseMovesUnreleasedEntries verifies the intro stays under Unreleased and entries move to the new section.
+func TestCutChangelogReleaseMovesUnreleasedEntries(t *testing.T) {
+	t.Parallel()
+
+	changelog := "# Changelog\n\n## Unreleased\n\nChanges land here first.\n\n### Builds\n\n- Faster builds.\n\n## v0.24.1\n\nReleased August 12, 2026.\n"
+	got, err := cutChangelogRelease(changelog, "v0.25.0", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("cutChangelogRelease() error = %v", err)
+	}
+	want := "# Changelog\n\n## Unreleased\n\nChanges land here first.\n\n## v0.25.0\n\nReleased October 18, 2026.\n\n### Builds\n\n- Faster builds.\n\n## v0.24.1\n\nReleased August 12, 2026.\n"
+	if got != want {
+		t.Fatalf("cutChangelogRelease() =\n%q\nwant\n%q", got, want)
+	}
+	if _, err := cutChangelogRelease(got, "v0.26.0", time.Now()); err == nil || !strings.Contains(err.Error(), "no Unreleased entries") {
+		t.Fatalf("cutChangelogRelease() error = %v, want empty Unreleased rejection", err)
+	}
+	if err := validateNextRelease("v0.24.1", "v0.24.0"); err == nil {
+		t.Fatal("validateNextRelease() error = nil, want older version rejection")
+	}
+}
+
+// TestCheckReleaseConsistencyFindsDisagreements verifies each place that carries the current version is compared with release.json.
+func TestCheckReleaseConsistencyFindsDisagreements(t *testing.T) {
+	t.Parallel()
+
+	repoRoot := t.TempDir()
+	writeTestFiles(t, repoRoot, map[string]string{
+		"docs/.vitepress/data/release.json": "{\n  \"latest\": \"v0.24.1\"\n}\n",
+		"docs/.vitepress/config.mts":        "import release from './data/release.json'\nconst nav = [{ text: `Latest tag ${release.latest}` }, { text: 'Latest tag v0.23.0' }]\n",
+		"docs/versions/index.md":            "`%%LATEST_RELEASE%%` is the latest tagged framework release.\n\nSee [notes](/versions/changelog#%%LATEST_RELEASE_ANCHOR%%) or v0.24.0.\n",
+		"docs/versions/changelog.md":        "# Changelog\n\n## Unreleased\n\n## v0.24.2\n\nShipped soon.\n\n## v0.24.1\n\nReleased August 12, 2026.\n",
+		"ai/docs-versioning.md":             "The current release is v0.24.0.\n",
+	})
+
+	got, err := checkReleaseConsistency(repoRoot, filepath.Join(repoRoot, "docs"), []string{"ai/docs-versioning.md"})
+	if err != nil {
+		t.Fatalf("checkReleaseConsistency() error = %v", err)
+	}
+	want := []string{
+		"docs/versions/changelog.md:5: newest changelog section is v0.24.2 but release.json says v0.24.1",
+		`docs/versions/changelog.md:5: v0.24.2 needs a "Released Month D, YYYY." line`,
+		"docs/versions/index.md:3: hard-coded v0.24.0; use %%LATEST_RELEASE%% so it follows release.json",
+		"docs/.vitepress/config.mts:2: hard-coded latest tag in the nav; use ${release.latest}",
+		"ai/docs-versioning.md:1: names v0.24.0 as current but release.json says v0.24.1",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("checkReleaseConsistency() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
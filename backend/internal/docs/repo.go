@@ -1,8 +1,15 @@
 package docs
 
-import "strings"
+import (
+	"path/filepath"
+	"strings"
+)
 
 // RepoConfig describes a repo to pull docs from.
+// Host, HostURL and Owner only need setting for repositories outside github.com/goforj;
+// HostURL is a self-hosted base such as https://git.example.com.
+// Private repos name the environment variable holding an HTTPS token in TokenEnv (TokenUser overrides the
+// host's default username) or select a private key with SSHKey; credentials never reach URLs, logs or .git/config.
 type RepoConfig struct {
 	Slug           string
 	Title          string
@@ -11,8 +18,26 @@ type RepoConfig struct {
 	Branch         string
 	OutputPath     string
 	ReadmePath     string
+	Host           RepoHost
+	HostURL        string
+	Owner          string
 	RepoName       string
+	TokenEnv       string
+	TokenUser      string
+	SSHKey         string
+	Keywords       []string
+	SidebarLabel   string
+	Pages          []DocsPage
 	FrameworkGuide FrameworkGuide
+	Drivers        *DriverSpec
+}
+
+// DocsPage is an extra Markdown file from a library repo published beside its main page.
+type DocsPage struct {
+	Source      string `yaml:"source"`
+	Slug        string `yaml:"slug"`
+	Title       string `yaml:"title"`
+	Description string `yaml:"description"`
 }
 
 // FrameworkGuide links a standalone library page to its canonical App guide.
@@ -22,21 +47,43 @@ type FrameworkGuide struct {
 	Summary string
 }
 
-func webGithubBase(repo RepoConfig) string {
-	if repo.RepoName != "" {
-		return ensureTrailingSlash("https://github.com/goforj/" + repo.RepoName)
+// libraryPageName is the generated page's file name without extension, shared by nested API, changelog and extra pages.
+func libraryPageName(repo RepoConfig) string {
+	return strings.TrimSuffix(filepath.Base(repo.OutputPath), filepath.Ext(repo.OutputPath))
+}
+
+// libraryRoute mirrors the VitePress libraryRewrites, which serve libraries/<page>.md at /<page>.
+func libraryRoute(repo RepoConfig) string {
+	return "/" + libraryPageName(repo)
+}
+
+// libraryTitle falls back to the slug for registry entries without a display title.
+func libraryTitle(repo RepoConfig) string {
+	if repo.Title == "" {
+		return repo.Slug
 	}
-	base := strings.TrimSuffix(repo.CloneURL, ".git")
-	return ensureTrailingSlash(base)
+	return repo.Title
+}
+
+// editReadmeURL points contributors at the source README because generated pages are overwritten on the next import.
+func editReadmeURL(repo RepoConfig) string {
+	readmePath := repo.ReadmePath
+	if readmePath == "" {
+		readmePath = "README.md"
+	}
+	return sourceLinks(repo).edit(repoBranch(repo), strings.TrimPrefix(readmePath, "/"))
+}
+
+// rawSourceBase is the prefix that repository-relative image paths are appended to.
+func rawSourceBase(repo RepoConfig, ref string) string {
+	return sourceLinks(repo).raw(ref, "")
 }
 
-func rawGithubBase(repo RepoConfig, branch string) string {
-	repoName := repo.Slug
-	if repo.RepoName != "" {
-		repoName = repo.RepoName
+func repoBranch(repo RepoConfig) string {
+	if repo.Branch == "" {
+		return "main"
 	}
-	base := "https://raw.githubusercontent.com/goforj/" + repoName + "/" + branch + "/"
-	return ensureTrailingSlash(base)
+	return repo.Branch
 }
 
 func ensureTrailingSlash(value string) string {
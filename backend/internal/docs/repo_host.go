@@ -0,0 +1,207 @@
+package docs
+
+import (
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// RepoHost names the forge a library is hosted on, which decides how links back to its source are built.
+type RepoHost string
+
+const (
+	HostGitHub RepoHost = "github"
+	HostGitLab RepoHost = "gitlab"
+	HostGitea  RepoHost = "gitea"
+)
+
+// defaultRepoOwner is the organization registry entries belong to unless they say otherwise.
+const defaultRepoOwner = "goforj"
+
+var commitSHARegex = regexp.MustCompile(`^[0-9a-f]{40}([0-9a-f]{24})?$`)
+
+// repoLinks builds browse, raw and edit URLs for one repository on its host.
+type repoLinks struct {
+	host    RepoHost
+	repoURL string
+	rawURL  string
+}
+
+// sourceLinks resolves the host, base URL, owner and repository name for a registry entry.
+// Owner and name fall back to the clone URL, then to the goforj organization and the slug.
+func sourceLinks(repo RepoConfig) repoLinks {
+	host := repoHostOf(repo)
+	base := strings.TrimSuffix(repo.HostURL, "/")
+	if base == "" {
+		base = defaultHostURL(host, repo.CloneURL)
+	}
+	cloneOwner, cloneName := splitClonePath(repo.CloneURL, base)
+	owner := firstNonEmpty(repo.Owner, cloneOwner, defaultRepoOwner)
+	name := firstNonEmpty(repo.RepoName, cloneName, repo.Slug)
+
+	links := repoLinks{host: host, repoURL: base + "/" + owner + "/" + name}
+	switch {
+	case host == HostGitHub && base == "https://github.com":
+		links.rawURL = "https://raw.githubusercontent.com/" + owner + "/" + name
+	case host == HostGitHub:
+		// GitHub Enterprise serves raw files from the repository route rather than a separate domain.
+		links.rawURL = links.repoURL + "/raw"
+	case host == HostGitLab:
+		links.rawURL = links.repoURL + "/-/raw"
+	default:
+		links.rawURL = links.repoURL + "/raw"
+	}
+	return links
+}
+
+// repoHostOf honors an explicit host and otherwise recognizes the public forges from the clone URL.
+func repoHostOf(repo RepoConfig) RepoHost {
+	if repo.Host != "" {
+		return repo.Host
+	}
+	if strings.Contains(strings.ToLower(repo.CloneURL), "gitlab.com") {
+		return HostGitLab
+	}
+	return HostGitHub
+}
+
+func defaultHostURL(host RepoHost, cloneURL string) string {
+	switch host {
+	case HostGitLab:
+		return "https://gitlab.com"
+	case HostGitea:
+		if hostname := cloneHostname(cloneURL); hostname != "" {
+			return "https://" + hostname
+		}
+		return "https://gitea.com"
+	default:
+		return "https://github.com"
+	}
+}
+
+// splitClonePath reads owner and name from HTTPS and scp-style clone URLs; GitLab subgroups stay part of the owner.
+func splitClonePath(cloneURL string, base string) (string, string) {
+	trimmed := strings.TrimSuffix(strings.TrimSpace(cloneURL), ".git")
+	var repoPath string
+	if parsed, err := url.Parse(trimmed); err == nil && parsed.Scheme != "" && parsed.Host != "" {
+		repoPath = parsed.Path
+		if baseURL, err := url.Parse(base); err == nil && strings.EqualFold(baseURL.Hostname(), parsed.Hostname()) {
+			repoPath = strings.TrimPrefix(repoPath, strings.TrimSuffix(baseURL.Path, "/"))
+		}
+	} else if _, after, found := strings.Cut(trimmed, ":"); found {
+		repoPath = after
+	}
+	repoPath = strings.Trim(repoPath, "/")
+	if !strings.Contains(repoPath, "/") {
+		return "", ""
+	}
+	return path.Dir(repoPath), path.Base(repoPath)
+}
+
+func cloneHostname(cloneURL string) string {
+	if parsed, err := url.Parse(cloneURL); err == nil && parsed.Host != "" {
+		return parsed.Hostname()
+	}
+	if before, _, found := strings.Cut(cloneURL, ":"); found {
+		_, host, _ := strings.Cut(before, "@")
+		return host
+	}
+	return ""
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// name is the label used in "View on ..." links.
+func (l repoLinks) name() string {
+	switch l.host {
+	case HostGitLab:
+		return "GitLab"
+	case HostGitea:
+		return "Gitea"
+	default:
+		return "GitHub"
+	}
+}
+
+// web is the repository home page without a trailing slash.
+func (l repoLinks) web() string {
+	return l.repoURL
+}
+
+func (l repoLinks) blob(ref string, filePath string) string {
+	switch l.host {
+	case HostGitLab:
+		return l.repoURL + "/-/blob/" + ref + "/" + filePath
+	case HostGitea:
+		return l.repoURL + "/src/" + giteaRefPath(ref) + "/" + filePath
+	default:
+		return l.repoURL + "/blob/" + ref + "/" + filePath
+	}
+}
+
+// tree links a directory; an empty path links the repository root at ref.
+func (l repoLinks) tree(ref string, dirPath string) string {
+	var route string
+	switch l.host {
+	case HostGitLab:
+		route = l.repoURL + "/-/tree/" + ref
+	case HostGitea:
+		// Gitea browses files and directories through the same route.
+		route = l.repoURL + "/src/" + giteaRefPath(ref)
+	default:
+		route = l.repoURL + "/tree/" + ref
+	}
+	if dirPath == "" {
+		return route
+	}
+	return route + "/" + dirPath
+}
+
+// raw serves file bytes; passing an empty path yields a prefix that relative image paths can be appended to.
+func (l repoLinks) raw(ref string, filePath string) string {
+	if l.host == HostGitea {
+		return l.rawURL + "/" + giteaRefPath(ref) + "/" + filePath
+	}
+	return l.rawURL + "/" + ref + "/" + filePath
+}
+
+func (l repoLinks) edit(branch string, filePath string) string {
+	switch l.host {
+	case HostGitLab:
+		return l.repoURL + "/-/edit/" + branch + "/" + filePath
+	case HostGitea:
+		return l.repoURL + "/_edit/" + branch + "/" + filePath
+	default:
+		return l.repoURL + "/edit/" + branch + "/" + filePath
+	}
+}
+
+func (l repoLinks) tag(tag string) string {
+	if l.host == HostGitLab {
+		return l.repoURL + "/-/tags/" + tag
+	}
+	return l.repoURL + "/releases/tag/" + tag
+}
+
+func (l repoLinks) commit(sha string) string {
+	if l.host == HostGitLab {
+		return l.repoURL + "/-/commit/" + sha
+	}
+	return l.repoURL + "/commit/" + sha
+}
+
+// giteaRefPath qualifies the ref because Gitea routes branches and commits separately.
+func giteaRefPath(ref string) string {
+	if commitSHARegex.MatchString(ref) {
+		return "commit/" + ref
+	}
+	return "branch/" + ref
+}
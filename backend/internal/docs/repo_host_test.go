@@ -0,0 +1,119 @@
+package docs
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestSourceLinksFollowHostURLPatterns verifies each forge gets its own blob, tree, raw, edit, tag and commit routes.
+func TestSourceLinksFollowHostURLPatterns(t *testing.T) {
+	t.Parallel()
+
+	sha := "5ab5e9cbabd399576026d337f50b9df62c00aa8e"
+	tests := []struct {
+		name string
+		repo RepoConfig
+		want []string
+	}{
+		{
+			name: "github",
+			repo: RepoConfig{Slug: "cache", CloneURL: "https://github.com/goforj/cache.git"},
+			want: []string{
+				"https://github.com/goforj/cache",
+				"https://github.com/goforj/cache/blob/main/docs/a.md",
+				"https://github.com/goforj/cache/tree/main/driver",
+				"https://raw.githubusercontent.com/goforj/cache/" + sha + "/img/a.png",
+				"https://github.com/goforj/cache/edit/main/README.md",
+				"https://github.com/goforj/cache/releases/tag/v1.0.0",
+				"https://github.com/goforj/cache/commit/" + sha,
+			},
+		},
+		{
+			name: "github enterprise",
+			repo: RepoConfig{Slug: "cache", Host: HostGitHub, HostURL: "https://ghe.example.com/", Owner: "platform", CloneURL: "git@ghe.example.com:platform/cache.git"},
+			want: []string{
+				"https://ghe.example.com/platform/cache",
+				"https://ghe.example.com/platform/cache/blob/main/docs/a.md",
+				"https://ghe.example.com/platform/cache/tree/main/driver",
+				"https://ghe.example.com/platform/cache/raw/" + sha + "/img/a.png",
+				"https://ghe.example.com/platform/cache/edit/main/README.md",
+				"https://ghe.example.com/platform/cache/releases/tag/v1.0.0",
+				"https://ghe.example.com/platform/cache/commit/" + sha,
+			},
+		},
+		{
+			name: "self-hosted gitlab subgroup",
+			repo: RepoConfig{Slug: "billing", Host: HostGitLab, HostURL: "https://git.example.com/gitlab", CloneURL: "https://git.example.com/gitlab/platform/go/billing-lib.git"},
+			want: []string{
+				"https://git.example.com/gitlab/platform/go/billing-lib",
+				"https://git.example.com/gitlab/platform/go/billing-lib/-/blob/main/docs/a.md",
+				"https://git.example.com/gitlab/platform/go/billing-lib/-/tree/main/driver",
+				"https://git.example.com/gitlab/platform/go/billing-lib/-/raw/" + sha + "/img/a.png",
+				"https://git.example.com/gitlab/platform/go/billing-lib/-/edit/main/README.md",
+				"https://git.example.com/gitlab/platform/go/billing-lib/-/tags/v1.0.0",
+				"https://git.example.com/gitlab/platform/go/billing-lib/-/commit/" + sha,
+			},
+		},
+		{
+			name: "gitea",
+			repo: RepoConfig{Slug: "audit", Host: HostGitea, CloneURL: "ssh://git@gitea.internal:2222/tools/audit.git", HostURL: "https://gitea.internal"},
+			want: []string{
+				"https://gitea.internal/tools/audit",
+				"https://gitea.internal/tools/audit/src/branch/main/docs/a.md",
+				"https://gitea.internal/tools/audit/src/branch/main/driver",
+				"https://gitea.internal/tools/audit/raw/commit/" + sha + "/img/a.png",
+				"https://gitea.internal/tools/audit/_edit/main/README.md",
+				"https://gitea.internal/tools/audit/releases/tag/v1.0.0",
+				"https://gitea.internal/tools/audit/commit/" + sha,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			links := sourceLinks(test.repo)
+			got := []string{
+				links.web(),
+				links.blob("main", "docs/a.md"),
+				links.tree("main", "driver"),
+				links.raw(sha, "img/a.png"),
+				links.edit("main", "README.md"),
+				links.tag("v1.0.0"),
+				links.commit(sha),
+			}
+			for i := range got {
+				if got[i] != test.want[i] {
+					t.Fatalf("sourceLinks() url %d = %q, want %q", i, got[i], test.want[i])
+				}
+			}
+		})
+	}
+}
+
+// TestSourceLinksFallBackToGoforjOrganization verifies entries without a parseable clone URL keep the historical goforj defaults.
+func TestSourceLinksFallBackToGoforjOrganization(t *testing.T) {
+	t.Parallel()
+
+	links := sourceLinks(RepoConfig{Slug: "str"})
+	if links.web() != "https://github.com/goforj/str" || rawSourceBase(RepoConfig{Slug: "str"}, "main") != "https://raw.githubusercontent.com/goforj/str/main/" {
+		t.Fatalf("sourceLinks() = %+v, want goforj defaults", links)
+	}
+	if got := sourceLinks(RepoConfig{Slug: "x", CloneURL: "https://gitlab.com/acme/x.git"}).tree("main", ""); got != "https://gitlab.com/acme/x/-/tree/main" {
+		t.Fatalf("tree() = %q, want gitlab.com detected from the clone URL", got)
+	}
+}
+
+// TestRewriteMarkdownLinksUsesRepoHost verifies relative README links resolve to the library's own forge.
+func TestRewriteMarkdownLinksUsesRepoHost(t *testing.T) {
+	t.Parallel()
+
+	repo := RepoConfig{Slug: "audit", Host: HostGitea, HostURL: "https://gitea.internal", Owner: "tools", Branch: "trunk"}
+	got := rewriteMarkdownLinks("See [docs](docs/setup.md#install) and [drivers](driver/).", repo, "")
+	for _, want := range []string{
+		"(https://gitea.internal/tools/audit/src/branch/trunk/docs/setup.md#install)",
+		"(https://gitea.internal/tools/audit/src/branch/trunk/driver/)",
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("rewriteMarkdownLinks() missing %q in:\n%s", want, got)
+		}
+	}
+}
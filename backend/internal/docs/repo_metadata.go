@@ -0,0 +1,208 @@
+package docs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+)
+
+// RepoMetadata captures checkout facts that generated pages expose for install commands, version badges and freshness labels.
+type RepoMetadata struct {
+	ModulePath string
+	GoVersion  string
+	LatestTag  string
+	CommitSHA  string
+	CommitDate string
+	License    string
+	// Stats is set only by docs:generate, which counts the checkout it renders.
+	Stats *RepoStats
+	// Languages lists the published translations; Lang is set on a translated page's copy.
+	Languages []string
+	Lang      string
+}
+
+var licenseFileNames = []string{"LICENSE", "LICENSE.md", "LICENSE.txt", "LICENCE", "LICENCE.md", "COPYING"}
+
+// licenseSignatures are ordered from most to least specific so GPL variants are not reported as their shorter relatives.
+var licenseSignatures = []struct {
+	spdx    string
+	pattern *regexp.Regexp
+}{
+	{spdx: "AGPL-3.0", pattern: regexp.MustCompile(`(?i)GNU AFFERO GENERAL PUBLIC LICENSE\s+Version 3`)},
+	{spdx: "LGPL-3.0", pattern: regexp.MustCompile(`(?i)GNU LESSER GENERAL PUBLIC LICENSE\s+Version 3`)},
+	{spdx: "GPL-3.0", pattern: regexp.MustCompile(`(?i)GNU GENERAL PUBLIC LICENSE\s+Version 3`)},
+	{spdx: "GPL-2.0", pattern: regexp.MustCompile(`(?i)GNU GENERAL PUBLIC LICENSE\s+Version 2`)},
+	{spdx: "MPL-2.0", pattern: regexp.MustCompile(`(?i)Mozilla Public License,?\s+(?:Version|v\.)\s*2\.0`)},
+	{spdx: "Apache-2.0", pattern: regexp.MustCompile(`(?i)Apache License,?\s+Version 2\.0`)},
+	{spdx: "BSD-3-Clause", pattern: regexp.MustCompile(`(?is)Redistribution and use in source and binary forms.*Neither the name`)},
+	{spdx: "BSD-2-Clause", pattern: regexp.MustCompile(`(?i)Redistribution and use in source and binary forms`)},
+	{spdx: "ISC", pattern: regexp.MustCompile(`(?i)Permission to use, copy, modify, and(?:/or)? distribute this software for any purpose`)},
+	{spdx: "Unlicense", pattern: regexp.MustCompile(`(?i)This is free and unencumbered software released into the public domain`)},
+	{spdx: "MIT", pattern: regexp.MustCompile(`(?i)Permission is hereby granted, free of charge, to any person obtaining a copy`)},
+}
+
+// readRepoMetadata treats every field as optional because local sources may lack git history, tags or a module file.
+// Only synced checkouts ask the remote for tags; a local source is read as it is on disk.
+func readRepoMetadata(dir string, auth gitAuth, synced bool) (RepoMetadata, error) {
+	var meta RepoMetadata
+
+	modulePath, goVersion, err := readGoModule(dir)
+	if err != nil {
+		return meta, err
+	}
+	meta.ModulePath = modulePath
+	meta.GoVersion = goVersion
+
+	license, err := detectLicense(dir)
+	if err != nil {
+		return meta, err
+	}
+	meta.License = license
+
+	if isGitRepo(dir) {
+		meta.CommitSHA, meta.CommitDate = readHeadCommit(dir)
+		meta.LatestTag = latestReleaseTag(listRepoTags(dir, auth, synced), meta.ModulePath)
+	}
+
+	return meta, nil
+}
+
+// sourceRef pins source links to the generated commit when known so line anchors do not drift as the branch moves.
+func sourceRef(repo RepoConfig, meta RepoMetadata) string {
+	if meta.CommitSHA != "" {
+		return meta.CommitSHA
+	}
+	if repo.Branch != "" {
+		return repo.Branch
+	}
+	return "main"
+}
+
+// readGoModule returns empty values for repositories that are not Go modules.
+func readGoModule(dir string) (string, string, error) {
+	file, err := readGoModFile(dir)
+	if err != nil || file == nil {
+		return "", "", err
+	}
+
+	modulePath := ""
+	if file.Module != nil {
+		modulePath = file.Module.Mod.Path
+	}
+	goVersion := ""
+	if file.Go != nil {
+		goVersion = file.Go.Version
+	}
+	return modulePath, goVersion, nil
+}
+
+// readGoModFile parses the checkout root module leniently so unknown directives from newer toolchains do not block generation.
+func readGoModFile(dir string) (*modfile.File, error) {
+	path := filepath.Join(dir, "go.mod")
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read go.mod: %w", err)
+	}
+
+	file, err := modfile.ParseLax(path, content, nil)
+	if err != nil {
+		return nil, fmt.Errorf("parse go.mod: %w", err)
+	}
+	return file, nil
+}
+
+// detectLicense reports the SPDX identifier of the first recognizable license file at the repository root.
+func detectLicense(dir string) (string, error) {
+	for _, name := range licenseFileNames {
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("read %s: %w", name, err)
+		}
+		return licenseSPDX(string(content)), nil
+	}
+	return "", nil
+}
+
+// licenseSPDX matches canonical license text instead of file names because repositories rarely name the license variant.
+func licenseSPDX(content string) string {
+	for _, signature := range licenseSignatures {
+		if signature.pattern.MatchString(content) {
+			return signature.spdx
+		}
+	}
+	return ""
+}
+
+// readHeadCommit leaves both values empty when the checkout has no readable HEAD.
+func readHeadCommit(dir string) (string, string) {
+	output, err := gitOutput(dir, "log", "-1", "--format=%H%x00%cI")
+	if err != nil {
+		return "", ""
+	}
+	sha, date, _ := strings.Cut(strings.TrimSpace(output), "\x00")
+	return sha, date
+}
+
+// listRepoTags asks the remote first for synced checkouts because shallow clones only carry tags that point at the
+// fetched commit. Local sources list their own tags so offline runs never wait on the network or prompt for credentials.
+func listRepoTags(dir string, auth gitAuth, remote bool) []string {
+	if remote {
+		output, err := remoteGitOutput(dir, auth, "ls-remote", "--tags", "--refs", "origin")
+		if err != nil {
+			return localRepoTags(dir)
+		}
+		var tags []string
+		for _, line := range strings.Split(output, "\n") {
+			_, ref, ok := strings.Cut(strings.TrimSpace(line), "\t")
+			if ok {
+				tags = append(tags, strings.TrimPrefix(ref, "refs/tags/"))
+			}
+		}
+		return tags
+	}
+	return localRepoTags(dir)
+}
+
+func localRepoTags(dir string) []string {
+	output, err := gitOutput(dir, "tag", "--list")
+	if err != nil {
+		return nil
+	}
+	return strings.Fields(output)
+}
+
+// latestReleaseTag ignores prereleases so install snippets and version badges never advertise release candidates, and
+// tags from another major version than modulePath, which `go get modulePath@tag` could not install.
+func latestReleaseTag(tags []string, modulePath string) string {
+	_, pathMajor, _ := module.SplitPathVersion(modulePath)
+	releases := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if modulePath != "" && module.CheckPathMajor(tag, pathMajor) != nil {
+			continue
+		}
+		if semver.IsValid(tag) && semver.Prerelease(tag) == "" && semver.Build(tag) == "" {
+			releases = append(releases, tag)
+		}
+	}
+	if len(releases) == 0 {
+		return ""
+	}
+	sort.Slice(releases, func(i, j int) bool {
+		return semver.Compare(releases[i], releases[j]) > 0
+	})
+	return releases[0]
+}
@@ -0,0 +1,116 @@
+package docs
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// TestLatestReleaseTag verifies semantic ordering and that prereleases, unrelated tags and tags from another major
+// version than the module path are never advertised.
+func TestLatestReleaseTag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		tags   []string
+		module string
+		want   string
+	}{
+		{name: "empty", tags: nil, module: "github.com/goforj/cache", want: ""},
+		{name: "numeric ordering", tags: []string{"v1.9.0", "v1.10.0", "v1.2.3"}, module: "github.com/goforj/cache", want: "v1.10.0"},
+		{name: "skips prereleases", tags: []string{"v1.0.0", "v2.0.0-rc.1"}, module: "github.com/goforj/cache", want: "v1.0.0"},
+		{name: "skips non semver", tags: []string{"latest", "release-2", "v0.3.1"}, module: "github.com/goforj/cache", want: "v0.3.1"},
+		{name: "v1 module skips v2 tags", tags: []string{"v1.4.0", "v2.1.0", "v2.0.0"}, module: "github.com/goforj/cache", want: "v1.4.0"},
+		{name: "v2 module skips v1 tags", tags: []string{"v1.4.0", "v2.1.0", "v3.0.0"}, module: "github.com/goforj/cache/v2", want: "v2.1.0"},
+		{name: "non Go repo keeps every major", tags: []string{"v1.4.0", "v2.1.0"}, want: "v2.1.0"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := latestReleaseTag(test.tags, test.module); got != test.want {
+				t.Fatalf("latestReleaseTag(%v, %q) = %q, want %q", test.tags, test.module, got, test.want)
+			}
+		})
+	}
+}
+
+// TestLicenseSPDX verifies canonical license text maps to the SPDX identifiers shown in page badges.
+func TestLicenseSPDX(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"MIT License\n\nPermission is hereby granted, free of charge, to any person obtaining a copy": "MIT",
+		"Apache License\nVersion 2.0, January 2004":                                                   "Apache-2.0",
+		"GNU LESSER GENERAL PUBLIC LICENSE\n Version 3, 29 June 2007":                                 "LGPL-3.0",
+		"All rights reserved.": "",
+	}
+	for content, want := range tests {
+		if got := licenseSPDX(content); got != want {
+			t.Fatalf("licenseSPDX(%q) = %q, want %q", content, got, want)
+		}
+	}
+}
+
+// TestReadRepoMetadataWithoutGit verifies module and license facts are read from plain local sources.
+func TestReadRepoMetadataWithoutGit(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	goMod := "module github.com/goforj/cache\n\ngo 1.24.4\n\nrequire github.com/goforj/env v1.0.0\n"
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(goMod), 0o644); err != nil {
+		t.Fatalf("write go.mod: %v", err)
+	}
+	license := "Permission is hereby granted, free of charge, to any person obtaining a copy"
+	if err := os.WriteFile(filepath.Join(dir, "LICENSE"), []byte(license), 0o644); err != nil {
+		t.Fatalf("write LICENSE: %v", err)
+	}
+
+	got, err := readRepoMetadata(dir, gitAuth{}, false)
+	if err != nil {
+		t.Fatalf("readRepoMetadata() error = %v", err)
+	}
+	want := RepoMetadata{ModulePath: "github.com/goforj/cache", GoVersion: "1.24.4", License: "MIT"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("readRepoMetadata() = %#v, want %#v", got, want)
+	}
+}
+
+// TestReadRepoMetadataWithoutModule verifies non-Go repositories still generate pages.
+func TestReadRepoMetadataWithoutModule(t *testing.T) {
+	t.Parallel()
+
+	got, err := readRepoMetadata(t.TempDir(), gitAuth{}, false)
+	if err != nil {
+		t.Fatalf("readRepoMetadata() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, RepoMetadata{}) {
+		t.Fatalf("readRepoMetadata() = %#v, want empty metadata", got)
+	}
+}
+
+// TestReadRepoMetadataTagSource verifies local sources read their own tags while synced checkouts ask origin.
+func TestReadRepoMetadataTagSource(t *testing.T) {
+	t.Parallel()
+
+	remote := t.TempDir()
+	runTestGit(t, remote, "init", "-q")
+	runTestGit(t, remote, "commit", "-q", "--allow-empty", "-m", "Initial")
+	runTestGit(t, remote, "tag", "v2.0.0")
+
+	dir := t.TempDir()
+	runTestGit(t, dir, "init", "-q")
+	runTestGit(t, dir, "commit", "-q", "--allow-empty", "-m", "Initial")
+	runTestGit(t, dir, "tag", "v1.0.0")
+	runTestGit(t, dir, "remote", "add", "origin", remote)
+
+	for synced, want := range map[bool]string{false: "v1.0.0", true: "v2.0.0"} {
+		got, err := readRepoMetadata(dir, gitAuth{}, synced)
+		if err != nil {
+			t.Fatalf("readRepoMetadata(synced=%t) error = %v", synced, err)
+		}
+		if got.LatestTag != want {
+			t.Fatalf("readRepoMetadata(synced=%t).LatestTag = %q, want %q", synced, got.LatestTag, want)
+		}
+	}
+}
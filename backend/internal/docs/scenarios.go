@@ -0,0 +1,404 @@
+package docs
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	scenarioPagesDir     = "scenarios"
+	scenarioSpecsDir     = "internal/scenarios/specs"
+	scenarioFrameworkURL = "https://github.com/goforj/goforj.git"
+	scenarioBanner       = "This page is generated from an executable spec. An automated suite renders a fresh App from the current GoForj templates, applies every step below in order, and runs every verification command. If any step fails, the page does not ship."
+)
+
+// scenarioSpec is one executable scenario from the framework repo's internal/scenarios/specs. Only the fields that
+// shape the page are read here; the runner's own settings such as components are carried but not rendered.
+type scenarioSpec struct {
+	ID          string           `yaml:"id"`
+	Title       string           `yaml:"title"`
+	Description string           `yaml:"description"`
+	DependsOn   []string         `yaml:"depends_on"`
+	Components  []string         `yaml:"components"`
+	Markdown    scenarioMarkdown `yaml:"markdown"`
+	Setup       *scenarioSetup   `yaml:"setup"`
+	Steps       []scenarioStep   `yaml:"steps"`
+	Verify      scenarioVerify   `yaml:"verify"`
+	file        string
+}
+
+// scenarioMarkdown holds the prose around the executable steps. Path places the scenario on the verified path shown
+// on /scenarios/; focused workflows leave it unset.
+type scenarioMarkdown struct {
+	Path           *scenarioPathPosition `yaml:"path"`
+	Intro          string                `yaml:"intro"`
+	BuildTitle     string                `yaml:"build_title"`
+	Build          []string              `yaml:"build"`
+	Diagrams       []scenarioDiagram     `yaml:"diagrams"`
+	Prerequisites  string                `yaml:"prerequisites"`
+	GoldenPath     *scenarioGoldenPath   `yaml:"golden_path"`
+	Files          []string              `yaml:"files"`
+	FileGroups     []scenarioFileGroup   `yaml:"file_groups"`
+	FilesNote      string                `yaml:"files_note"`
+	Sections       []scenarioSection     `yaml:"sections"`
+	CommonMistakes []string              `yaml:"common_mistakes"`
+	NextSteps      []string              `yaml:"next_steps"`
+}
+
+type scenarioPathPosition struct {
+	Order   int `yaml:"order"`
+	Minutes int `yaml:"minutes"`
+}
+
+type scenarioDiagram struct {
+	Language string `yaml:"language"`
+	Content  string `yaml:"content"`
+}
+
+type scenarioGoldenPath struct {
+	Before string `yaml:"before"`
+	After  string `yaml:"after"`
+}
+
+type scenarioFileGroup struct {
+	Title string   `yaml:"title"`
+	Files []string `yaml:"files"`
+}
+
+type scenarioSection struct {
+	Title   string `yaml:"title"`
+	Content string `yaml:"content"`
+}
+
+// scenarioSetup prepares fixture state that is not part of the taught workflow; only its check commands are shown.
+type scenarioSetup struct {
+	Steps  []scenarioStep `yaml:"steps"`
+	Verify scenarioVerify `yaml:"verify"`
+}
+
+// scenarioStep applies its file edits before its run commands, matching the runner.
+type scenarioStep struct {
+	Title       string         `yaml:"title"`
+	Description string         `yaml:"description"`
+	Edits       []scenarioEdit `yaml:"edits"`
+	Run         []string       `yaml:"run"`
+}
+
+// scenarioEdit is a write, append or replace. A replace with an empty replacement removes the matched block.
+type scenarioEdit struct {
+	Type     string `yaml:"type"`
+	Path     string `yaml:"path"`
+	Content  string `yaml:"content"`
+	Find     string `yaml:"find"`
+	Replace  string `yaml:"replace"`
+	Language string `yaml:"language"`
+}
+
+type scenarioVerify struct {
+	Commands []scenarioCommand `yaml:"commands"`
+}
+
+// scenarioCommand is a verification command and, optionally, output it must include.
+type scenarioCommand struct {
+	Run    string `yaml:"run"`
+	Expect string `yaml:"expect"`
+}
+
+// loadScenarioSpecs reads every *.yaml spec in dir, sorted by file name.
+func loadScenarioSpecs(dir string) ([]scenarioSpec, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no scenario specs in %s (pass --specs or --sync)", dir)
+	}
+	sort.Strings(files)
+	specs := make([]scenarioSpec, 0, len(files))
+	seen := map[string]string{}
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", filepath.Base(file), err)
+		}
+		var spec scenarioSpec
+		if err := yaml.Unmarshal(content, &spec); err != nil {
+			return nil, fmt.Errorf("parse %s: %w", filepath.Base(file), err)
+		}
+		spec.file = filepath.Base(file)
+		if err := spec.validate(); err != nil {
+			return nil, err
+		}
+		if previous, ok := seen[spec.ID]; ok {
+			return nil, fmt.Errorf("%s: scenario id %s is already used by %s", spec.file, spec.ID, previous)
+		}
+		seen[spec.ID] = spec.file
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+func (spec scenarioSpec) validate() error {
+	if spec.ID == "" || spec.Title == "" || spec.Description == "" {
+		return fmt.Errorf("%s: id, title and description are required", spec.file)
+	}
+	for i, step := range spec.Steps {
+		if step.Title == "" {
+			return fmt.Errorf("%s: step %d needs a title", spec.file, i+1)
+		}
+		for _, edit := range step.Edits {
+			switch edit.Type {
+			case "write", "append":
+			case "replace":
+				if edit.Find == "" {
+					return fmt.Errorf("%s: step %d replace in %s needs find", spec.file, i+1, edit.Path)
+				}
+			default:
+				return fmt.Errorf("%s: step %d has unknown edit type %q", spec.file, i+1, edit.Type)
+			}
+			if edit.Path == "" {
+				return fmt.Errorf("%s: step %d %s edit needs a path", spec.file, i+1, edit.Type)
+			}
+		}
+	}
+	return nil
+}
+
+// scenarioPage is where a spec's page is written, relative to the docs root.
+func scenarioPage(spec scenarioSpec) string {
+	return path.Join(scenarioPagesDir, spec.ID+".md")
+}
+
+// renderScenarioPage writes a spec as the public page. pathLength is how many scenarios are on the verified path.
+func renderScenarioPage(spec scenarioSpec, pathLength int) string {
+	var blocks []string
+	add := func(block string) {
+		if block = strings.TrimSpace(block); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+	markdown := spec.Markdown
+
+	add(fmt.Sprintf("---\ntitle: %s\ndescription: %s\n---", strconv.Quote(spec.Title), strconv.Quote(spec.Description)))
+	add("# " + spec.Title)
+	add("::: info Verified Scenario\n" + scenarioBanner + "\n:::")
+	if markdown.Path != nil {
+		add(fmt.Sprintf("Scenario %d of %d in the [verified path](/%s/). Plan on about %d minutes.", markdown.Path.Order, pathLength, scenarioPagesDir, markdown.Path.Minutes))
+	}
+	add(markdown.Intro)
+
+	if len(markdown.Build) > 0 {
+		title := markdown.BuildTitle
+		if title == "" {
+			title = "What You Will Build"
+		}
+		add("## " + title)
+		add(markdownList(markdown.Build))
+	}
+	for _, diagram := range markdown.Diagrams {
+		add(fence(diagram.Language, diagram.Content))
+	}
+	if markdown.Prerequisites != "" {
+		add("## Prerequisites")
+		add(markdown.Prerequisites)
+	}
+	if markdown.GoldenPath != nil {
+		add("## Golden Path State")
+		add("Before this scenario, " + strings.TrimSpace(markdown.GoldenPath.Before))
+		add("After this scenario, " + strings.TrimSpace(markdown.GoldenPath.After))
+	}
+	if len(markdown.FileGroups) > 0 || len(markdown.Files) > 0 {
+		add("## Files")
+		add("This scenario edits or creates:")
+		if len(markdown.FileGroups) > 0 {
+			for _, group := range markdown.FileGroups {
+				add("**" + group.Title + "**")
+				add(fence("text", strings.Join(group.Files, "\n")))
+			}
+		} else {
+			add(fence("text", strings.Join(markdown.Files, "\n")))
+		}
+		add(markdown.FilesNote)
+	}
+
+	if spec.Setup != nil && len(spec.Setup.Verify.Commands) > 0 {
+		add("## Starting State")
+		add("The scenario prepares and verifies this fixture state before the target workflow begins.")
+		add("The starting state is checked with:")
+		for _, command := range spec.Setup.Verify.Commands {
+			add(renderScenarioCommand(command))
+		}
+	}
+	for i, step := range spec.Steps {
+		add(fmt.Sprintf("## Step %d: %s", i+1, step.Title))
+		add(step.Description)
+		for _, edit := range step.Edits {
+			add(renderScenarioEdit(edit))
+		}
+		for _, command := range step.Run {
+			add(fence("bash", command))
+		}
+	}
+	if len(spec.Verify.Commands) > 0 {
+		add("## Build and Verify")
+		for _, command := range spec.Verify.Commands {
+			add(renderScenarioCommand(command))
+		}
+	}
+	for _, section := range markdown.Sections {
+		add("## " + section.Title)
+		add(section.Content)
+	}
+	if len(markdown.CommonMistakes) > 0 {
+		add("## Common Mistakes")
+		add("::: warning Common mistakes\n" + markdownList(markdown.CommonMistakes) + "\n:::")
+	}
+	if len(markdown.NextSteps) > 0 {
+		add("## Next Steps")
+		add(markdownList(markdown.NextSteps))
+	}
+	return strings.Join(blocks, "\n\n") + "\n"
+}
+
+func renderScenarioEdit(edit scenarioEdit) string {
+	language := edit.Language
+	if language == "" {
+		language = scenarioFileLanguage(edit.Path)
+	}
+	switch {
+	case edit.Type == "write":
+		return fmt.Sprintf("Create or replace `%s`:\n\n%s", edit.Path, fence(language, edit.Content))
+	case edit.Type == "append":
+		return fmt.Sprintf("Append to `%s`:\n\n%s", edit.Path, fence(language, edit.Content))
+	case strings.TrimSpace(edit.Replace) == "":
+		return fmt.Sprintf("Remove from `%s`:\n\n%s", edit.Path, fence(language, edit.Find))
+	default:
+		return fmt.Sprintf("Update `%s` so it includes:\n\n%s", edit.Path, fence(language, edit.Replace))
+	}
+}
+
+func renderScenarioCommand(command scenarioCommand) string {
+	block := fence("bash", command.Run)
+	if strings.TrimSpace(command.Expect) != "" {
+		block += "\n\nExpected output includes:\n\n" + fence("text", command.Expect)
+	}
+	return block
+}
+
+// scenarioFileLanguage picks the fence language for an edited file from its name.
+func scenarioFileLanguage(file string) string {
+	base := path.Base(file)
+	if base == ".env" || strings.HasPrefix(base, ".env.") {
+		return "dotenv"
+	}
+	switch path.Ext(base) {
+	case ".go":
+		return "go"
+	case ".sql":
+		return "sql"
+	case ".yaml", ".yml":
+		return "yaml"
+	case ".json":
+		return "json"
+	case ".ts":
+		return "ts"
+	case ".vue":
+		return "vue"
+	case ".sh":
+		return "bash"
+	default:
+		return "text"
+	}
+}
+
+func fence(language string, content string) string {
+	return "```" + language + "\n" + strings.TrimRight(content, "\n") + "\n```"
+}
+
+func markdownList(items []string) string {
+	lines := make([]string, 0, len(items))
+	for _, item := range items {
+		lines = append(lines, "- "+strings.TrimSpace(item))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderScenarioPages renders every spec keyed by page path. The verified path length counts specs that set a position.
+func renderScenarioPages(specs []scenarioSpec) map[string]string {
+	pathLength := 0
+	for _, spec := range specs {
+		if spec.Markdown.Path != nil {
+			pathLength++
+		}
+	}
+	pages := map[string]string{}
+	for _, spec := range specs {
+		pages[scenarioPage(spec)] = renderScenarioPage(spec, pathLength)
+	}
+	return pages
+}
+
+// scenarioDrift compares rendered pages with the docs tree. Pages that carry the generated banner but have no spec
+// are reported too, since they can no longer be regenerated.
+func scenarioDrift(docsRoot string, pages map[string]string) ([]string, error) {
+	docsDir := filepath.Base(docsRoot)
+	var problems []string
+	names := make([]string, 0, len(pages))
+	for name := range pages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		current, err := os.ReadFile(filepath.Join(docsRoot, filepath.FromSlash(name)))
+		if os.IsNotExist(err) {
+			problems = append(problems, fmt.Sprintf("%s: missing; run docs:scenarios to generate it", path.Join(docsDir, name)))
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", name, err)
+		}
+		if line := firstDifferentLine(string(current), pages[name]); line > 0 {
+			problems = append(problems, fmt.Sprintf("%s:%d: differs from its spec; run docs:scenarios to regenerate it", path.Join(docsDir, name), line))
+		}
+	}
+
+	existing, err := filepath.Glob(filepath.Join(docsRoot, scenarioPagesDir, "*.md"))
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range existing {
+		name := path.Join(scenarioPagesDir, filepath.Base(file))
+		if _, ok := pages[name]; ok {
+			continue
+		}
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", name, err)
+		}
+		if strings.Contains(string(content), scenarioBanner) {
+			problems = append(problems, fmt.Sprintf("%s:1: generated scenario page has no spec", path.Join(docsDir, name)))
+		}
+	}
+	return problems, nil
+}
+
+// firstDifferentLine returns the 1-based line where two texts first differ, or 0 when they are equal.
+func firstDifferentLine(a string, b string) int {
+	if a == b {
+		return 0
+	}
+	linesA, linesB := strings.Split(a, "\n"), strings.Split(b, "\n")
+	for i := 0; i < len(linesA) && i < len(linesB); i++ {
+		if linesA[i] != linesB[i] {
+			return i + 1
+		}
+	}
+	return min(len(linesA), len(linesB))
+}
@@ -0,0 +1,97 @@
+package docs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/goforj/docs/internal/logger"
+)
+
+// ScenariosCommand renders docs/scenarios pages from the framework's executable scenario specs, or reports drift.
+type ScenariosCommand struct {
+	Output   string `name:"output" type:"path" env:"DOCS_OUTPUT_DIR" help:"Docs root holding the scenario pages (defaults to ./docs or ../docs)"`
+	Specs    string `name:"specs" type:"path" env:"DOCS_SCENARIO_SPECS" help:"Directory of scenario specs (defaults to ../goforj/internal/scenarios/specs beside the docs repo)"`
+	Sync     bool   `name:"sync" help:"Read specs from a checkout of the framework repo synced into the cache dir instead of a local path"`
+	CacheDir string `name:"cache-dir" type:"path" env:"DOCS_CACHE_DIR" help:"Directory for the synced framework checkout (defaults to the system temp dir)"`
+	Check    bool   `name:"check" help:"Report pages that differ from their specs instead of writing"`
+	logger   *logger.AppLogger
+}
+
+// NewDocsScenariosCommand creates a new ScenariosCommand.
+func NewDocsScenariosCommand(logger *logger.AppLogger) *ScenariosCommand {
+	return &ScenariosCommand{
+		logger: logger,
+	}
+}
+
+// Run writes one page per spec, or with --check prints each drifted, missing or orphaned page and fails.
+func (c *ScenariosCommand) Run() error {
+	docsRoot, err := resolveDocsRoot(c.Output)
+	if err != nil {
+		return err
+	}
+	specsDir, err := c.specsDir(filepath.Dir(docsRoot))
+	if err != nil {
+		return err
+	}
+	specs, err := loadScenarioSpecs(specsDir)
+	if err != nil {
+		return err
+	}
+	c.logger.Info().Any("specs", specsDir).Any("scenarios", len(specs)).Msg("Loaded scenario specs")
+	pages := renderScenarioPages(specs)
+
+	if c.Check {
+		problems, err := scenarioDrift(docsRoot, pages)
+		if err != nil {
+			return err
+		}
+		for _, problem := range problems {
+			fmt.Println(problem)
+		}
+		if len(problems) > 0 {
+			return fmt.Errorf("%d scenario page(s) out of date", len(problems))
+		}
+		c.logger.Info().Any("pages", len(pages)).Msg("Scenario pages match their specs")
+		return nil
+	}
+
+	written := 0
+	for name, content := range pages {
+		file := filepath.Join(docsRoot, filepath.FromSlash(name))
+		if generatedPageMatches(file, content) {
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			return err
+		}
+		if err := writeGeneratedPage(file, content); err != nil {
+			return fmt.Errorf("write %s: %w", name, err)
+		}
+		written++
+	}
+	c.logger.Info().Any("pages", len(pages)).Any("written", written).Msg("Rendered scenario pages")
+	return nil
+}
+
+// specsDir resolves --specs, the synced framework checkout, or the sibling framework repo, in that order.
+func (c *ScenariosCommand) specsDir(repoRoot string) (string, error) {
+	if c.Specs != "" {
+		return c.Specs, nil
+	}
+	if c.Sync {
+		cacheRoot, err := resolveCacheRoot(c.CacheDir)
+		if err != nil {
+			return "", err
+		}
+		dir := checkoutDir(cacheRoot, "goforj")
+		action, err := cloneRepo(scenarioFrameworkURL, dir, "main", gitAuth{})
+		if err != nil {
+			return "", fmt.Errorf("sync framework repo: %w", err)
+		}
+		c.logger.Info().Any("repo", "goforj").Any("action", action).Msg("Synced framework repo")
+		return filepath.Join(dir, filepath.FromSlash(scenarioSpecsDir)), nil
+	}
+	return filepath.Join(siblingCheckout(repoRoot, "goforj"), filepath.FromSlash(scenarioSpecsDir)), nil
+}
@@ -0,0 +1,168 @@
+package docs
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testScenarioSpec = `id: json-api-route
+title: JSON API Route
+description: Build a JSON API route.
+depends_on: []
+markdown:
+  path:
+    order: 1
+    minutes: 15
+  intro: This scenario adds a route.
+  build:
+    - A controller.
+  diagrams:
+    - language: mermaid
+      content: |
+        flowchart LR
+          a --> b
+  prerequisites: Start from an App with HTTP enabled.
+  golden_path:
+    before: the App has no user routes.
+    after: one route is registered.
+  file_groups:
+    - title: Users feature
+      files:
+        - internal/users/service.go
+  files_note: Do not edit generated files by hand.
+  sections:
+    - title: Operations
+      content: Keep labels bounded.
+  common_mistakes:
+    - Do not put logic in controllers.
+  next_steps:
+    - Next, add a cache.
+steps:
+  - title: Scaffold the Controller
+    description: Start with the make command.
+    run:
+      - forj make:controller users
+  - title: Configure
+    edits:
+      - type: append
+        path: .env
+        content: |
+          USERS_ENABLED=true
+      - type: replace
+        path: app/wire/inject_services_app.go
+        find: users.NewService,
+        replace: |
+          users.NewService,
+          provideUsers,
+      - type: replace
+        path: app/wire/inject_jobs_app.go
+        find: |
+          reports.NewGenerateJob,
+      - type: write
+        path: internal/users/service.go
+        content: |
+          package users
+    run:
+      - forj build
+verify:
+  commands:
+    - run: forj route:list
+      expect: /api/v1/users/:id
+`
+
+// TestRenderScenarioPage verifies the page layout: banner, path position, files, steps with edits before commands and verification output.
+func TestRenderScenarioPage(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFiles(t, dir, map[string]string{"json-api-route.yaml": testScenarioSpec})
+	specs, err := loadScenarioSpecs(dir)
+	if err != nil {
+		t.Fatalf("loadScenarioSpecs() error = %v", err)
+	}
+	got := renderScenarioPages(specs)["scenarios/json-api-route.md"]
+	want := strings.Join([]string{
+		"---\ntitle: \"JSON API Route\"\ndescription: \"Build a JSON API route.\"\n---",
+		"# JSON API Route",
+		"::: info Verified Scenario\n" + scenarioBanner + "\n:::",
+		"Scenario 1 of 1 in the [verified path](/scenarios/). Plan on about 15 minutes.",
+		"This scenario adds a route.",
+		"## What You Will Build",
+		"- A controller.",
+		"```mermaid\nflowchart LR\n  a --> b\n```",
+		"## Prerequisites",
+		"Start from an App with HTTP enabled.",
+		"## Golden Path State",
+		"Before this scenario, the App has no user routes.",
+		"After this scenario, one route is registered.",
+		"## Files",
+		"This scenario edits or creates:",
+		"**Users feature**",
+		"```text\ninternal/users/service.go\n```",
+		"Do not edit generated files by hand.",
+		"## Step 1: Scaffold the Controller",
+		"Start with the make command.",
+		"```bash\nforj make:controller users\n```",
+		"## Step 2: Configure",
+		"Append to `.env`:\n\n```dotenv\nUSERS_ENABLED=true\n```",
+		"Update `app/wire/inject_services_app.go` so it includes:\n\n```go\nusers.NewService,\nprovideUsers,\n```",
+		"Remove from `app/wire/inject_jobs_app.go`:\n\n```go\nreports.NewGenerateJob,\n```",
+		"Create or replace `internal/users/service.go`:\n\n```go\npackage users\n```",
+		"```bash\nforj build\n```",
+		"## Build and Verify",
+		"```bash\nforj route:list\n```\n\nExpected output includes:\n\n```text\n/api/v1/users/:id\n```",
+		"## Operations",
+		"Keep labels bounded.",
+		"## Common Mistakes",
+		"::: warning Common mistakes\n- Do not put logic in controllers.\n:::",
+		"## Next Steps",
+		"- Next, add a cache.",
+	}, "\n\n") + "\n"
+	if got != want {
+		t.Fatalf("renderScenarioPage() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+// TestScenarioDriftReportsChangedMissingAndOrphanedPages verifies --check names the first differing line and ignores hand-written pages.
+func TestScenarioDriftReportsChangedMissingAndOrphanedPages(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	writeTestFiles(t, root, map[string]string{
+		"scenarios/index.md":   "# Runnable Scenarios\n",
+		"scenarios/a.md":       "# A\n\nEdited by hand.\n",
+		"scenarios/current.md": "# Current\n",
+		"scenarios/old.md":     "# Old\n\n" + scenarioBanner + "\n",
+	})
+	pages := map[string]string{
+		"scenarios/a.md":       "# A\n\nFrom the spec.\n",
+		"scenarios/b.md":       "# B\n",
+		"scenarios/current.md": "# Current\n",
+	}
+	problems, err := scenarioDrift(root, pages)
+	if err != nil {
+		t.Fatalf("scenarioDrift() error = %v", err)
+	}
+	docsDir := filepath.Base(root)
+	want := []string{
+		docsDir + "/scenarios/a.md:3: differs from its spec; run docs:scenarios to regenerate it",
+		docsDir + "/scenarios/b.md: missing; run docs:scenarios to generate it",
+		docsDir + "/scenarios/old.md:1: generated scenario page has no spec",
+	}
+	if strings.Join(problems, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("scenarioDrift() =\n%s\nwant\n%s", strings.Join(problems, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+// TestLoadScenarioSpecsRejectsUnknownEdits verifies malformed specs fail before any page is written.
+func TestLoadScenarioSpecsRejectsUnknownEdits(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	spec := "id: a\ntitle: A\ndescription: A.\nsteps:\n  - title: Edit\n    edits:\n      - type: patch\n        path: main.go\n"
+	writeTestFiles(t, dir, map[string]string{"a.yaml": spec})
+	if _, err := loadScenarioSpecs(dir); err == nil || !strings.Contains(err.Error(), `unknown edit type "patch"`) {
+		t.Fatalf("loadScenarioSpecs() error = %v, want unknown edit type", err)
+	}
+}
@@ -0,0 +1,325 @@
+package docs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+)
+
+const versionsManifestPath = "public/versions.json"
+
+var snapshotLineRegex = regexp.MustCompile(`^v\d+\.\d+$`)
+var editLinkFrontmatterRegex = regexp.MustCompile(`(?m)^editLink: .*\n`)
+
+// snapshotSections are the framework behavior sections ai/docs-versioning.md says to freeze. Libraries are pinned
+// separately; the landing pages, blog, translations and release history stay live.
+var snapshotSections = []string{"getting-started", "core", "applications", "data", "async", "testing", "operations", "developer-tools", "reference"}
+
+// versionsManifest is docs/public/versions.json: the frozen documentation lines, served at /versions.json and read by the site config.
+type versionsManifest struct {
+	Snapshots []versionSnapshot `json:"snapshots"`
+}
+
+// versionSnapshot records where a frozen line lives and which library tags its library pages are pinned to.
+type versionSnapshot struct {
+	Version   string            `json:"version"`
+	Release   string            `json:"release,omitempty"`
+	Path      string            `json:"path"`
+	Created   string            `json:"created"`
+	Libraries map[string]string `json:"libraries,omitempty"`
+}
+
+func snapshotDir(line string) string {
+	return path.Join("versions", line)
+}
+
+func snapshotRoute(line string) string {
+	return "/" + snapshotDir(line) + "/"
+}
+
+// snapshotPages lists the framework pages copied into a snapshot.
+func snapshotPages(files []string) []string {
+	var pages []string
+	for _, file := range files {
+		for _, section := range snapshotSections {
+			if strings.HasPrefix(file, section+"/") {
+				pages = append(pages, file)
+				break
+			}
+		}
+	}
+	return pages
+}
+
+// snapshotLinkRewriter maps absolute links onto the snapshot: framework pages and pinned libraries move under the version
+// prefix, everything else (unfrozen sections, blog, release history, assets, unpinned libraries) keeps pointing at the live site.
+func snapshotLinkRewriter(line string, pages []string, libraryRoutes map[string]string) func(string) string {
+	prefix := "/" + snapshotDir(line)
+	routes := map[string]struct{}{}
+	for _, page := range pages {
+		routes[pageRoute(page)] = struct{}{}
+	}
+	return func(target string) string {
+		if !strings.HasPrefix(target, "/") || strings.HasPrefix(target, "//") {
+			return target
+		}
+		pathPart, suffix := target, ""
+		if cut := strings.IndexAny(target, "?#"); cut >= 0 {
+			pathPart, suffix = target[:cut], target[cut:]
+		}
+		route := normalizeRoute(pathPart)
+		if pinned, ok := libraryRoutes[route]; ok {
+			return prefix + pinned + suffix
+		}
+		if _, ok := routes[route]; ok && route != "/" {
+			return prefix + pathPart + suffix
+		}
+		return target
+	}
+}
+
+// rewriteSnapshotLinks applies rewrite to link targets outside code fences, including frontmatter link: values.
+func rewriteSnapshotLinks(content string, rewrite func(string) string) string {
+	lines := strings.Split(content, "\n")
+	inFrontmatter := len(lines) > 0 && strings.TrimSpace(lines[0]) == "---"
+	for i := 1; inFrontmatter && i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == "---" {
+			break
+		}
+		lines[i] = replaceSubmatch(lines[i], frontmatterLinkRegex, 1, rewrite)
+	}
+	forEachProseLine(lines, func(i int, line string) {
+		line = replaceSubmatch(line, markdownLinkRegex, 2, rewrite)
+		line = replaceSubmatch(line, referenceDefinitionRegex, 1, rewrite)
+		line = replaceSubmatch(line, htmlLinkAttrRegex, 3, rewrite)
+		lines[i] = line
+	})
+	return strings.Join(lines, "\n")
+}
+
+// replaceSubmatch rewrites one capture group of every match, leaving the rest of the line untouched.
+func replaceSubmatch(line string, pattern *regexp.Regexp, group int, rewrite func(string) string) string {
+	matches := pattern.FindAllStringSubmatchIndex(line, -1)
+	for i := len(matches) - 1; i >= 0; i-- {
+		start, end := matches[i][2*group], matches[i][2*group+1]
+		if start < 0 {
+			continue
+		}
+		line = line[:start] + rewrite(line[start:end]) + line[end:]
+	}
+	return line
+}
+
+// renderPinnedLibraryPage renders a library page the way docs:generate does, but from a sparse clone of the tag, so the
+// text, links, commit fields and stats all describe the pinned release. The clone is discarded afterwards; dependency
+// sections are resolved against the synced checkouts in cacheRoot.
+func renderPinnedLibraryPage(repo RepoConfig, tag string, cacheRoot string) (string, error) {
+	if err := checkCloneCredentials(repo); err != nil {
+		return "", err
+	}
+	dir, err := os.MkdirTemp("", "goforj-docs-snapshot-")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(dir)
+
+	pinned := repo
+	pinned.Branch = tag
+	auth := repoGitAuth(repo)
+	checkout := filepath.Join(dir, repo.Slug)
+	if _, err := sparseCloneRepo(pinned, checkout, auth); err != nil {
+		return "", fmt.Errorf("clone %s at %s: %w", repo.Slug, tag, err)
+	}
+	prepared, err := prepareRepo(pinned, checkout, false)
+	if err != nil {
+		return "", err
+	}
+	prepared.Meta.LatestTag = tag
+	if err := ensureSparseSources(prepared.Repo, checkout, auth); err != nil {
+		return "", err
+	}
+	stats, err := countRepoStats(checkout)
+	if err != nil {
+		return "", fmt.Errorf("count stats for %s at %s: %w", repo.Slug, tag, err)
+	}
+	prepared.Meta.Stats = &stats
+
+	libraries := collectLibraryModules(defaultRepos(), map[string]libraryModules{repo.Slug: prepared.Modules}, cacheRoot)
+	linkable := make([]RepoConfig, 0, len(libraries))
+	for _, library := range libraries {
+		linkable = append(linkable, library.Repo)
+	}
+	deps := buildLibraryGraph(libraries).dependenciesFor(repo.Slug, linkable)
+	return transformReadme(string(prepared.Readme), prepared.Repo, rawSourceBase(prepared.Repo, tag), prepared.Meta, deps), nil
+}
+
+// buildSnapshot renders every file of a frozen line, keyed by path relative to the docs root: the framework pages with
+// links rewritten, the library pages rendered at their pinned tags, keyed by slug, and a generated landing page.
+func buildSnapshot(site docsSite, line string, release string, repos []RepoConfig, libraryPages map[string]string) (map[string]string, error) {
+	pages := snapshotPages(site.Files)
+	libraryRoutes := map[string]string{}
+	var pinned []RepoConfig
+	for _, repo := range repos {
+		if _, ok := libraryPages[repo.Slug]; ok {
+			libraryRoutes[libraryRoute(repo)] = "/" + strings.TrimSuffix(filepath.ToSlash(repo.OutputPath), ".md")
+			pinned = append(pinned, repo)
+		}
+	}
+	rewrite := snapshotLinkRewriter(line, pages, libraryRoutes)
+	dir := snapshotDir(line)
+
+	files := map[string]string{}
+	read := func(rel string) (string, error) {
+		content, err := os.ReadFile(filepath.Join(site.Root, filepath.FromSlash(rel)))
+		if err != nil {
+			return "", fmt.Errorf("read %s: %w", rel, err)
+		}
+		return string(content), nil
+	}
+	for _, page := range pages {
+		content, err := read(page)
+		if err != nil {
+			return nil, err
+		}
+		files[path.Join(dir, page)] = rewriteSnapshotLinks(content, rewrite)
+	}
+	// The edit link would edit the live README rather than the frozen one.
+	for _, repo := range pinned {
+		content := editLinkFrontmatterRegex.ReplaceAllString(libraryPages[repo.Slug], "")
+		files[path.Join(dir, filepath.ToSlash(repo.OutputPath))] = rewriteSnapshotLinks(content, rewrite)
+	}
+	files[path.Join(dir, "index.md")] = snapshotIndexPage(line, release, pages)
+	return files, nil
+}
+
+// frameworkLibraryTags reads the library versions required by the framework go.mod at a release tag. Libraries are matched
+// by the module path their synced checkout declares, so vanity import paths pin correctly.
+func frameworkLibraryTags(frameworkDir string, tag string, repos []RepoConfig, cacheRoot string) (map[string]string, error) {
+	content, err := gitOutput(frameworkDir, "show", tag+":go.mod")
+	if err != nil {
+		return nil, fmt.Errorf("read framework go.mod at %s: %w", tag, err)
+	}
+	file, err := modfile.ParseLax("go.mod", []byte(content), nil)
+	if err != nil {
+		return nil, fmt.Errorf("parse framework go.mod at %s: %w", tag, err)
+	}
+	required := map[string]string{}
+	for _, require := range file.Require {
+		required[require.Mod.Path] = require.Mod.Version
+	}
+	tags := map[string]string{}
+	for _, repo := range repos {
+		modulePath, _, err := readGoModule(checkoutDir(cacheRoot, repo.Slug))
+		if err != nil {
+			return nil, fmt.Errorf("read module path for %s: %w", repo.Slug, err)
+		}
+		if modulePath == "" {
+			continue
+		}
+		if version, ok := required[modulePath]; ok {
+			tags[repo.Slug] = version
+		}
+	}
+	return tags, nil
+}
+
+// latestLineTag picks the newest framework tag on the line, e.g. v0.24.1 for v0.24.
+func latestLineTag(frameworkDir string, line string) (string, error) {
+	output, err := gitOutput(frameworkDir, "tag", "--list", line+".*", "--sort=-version:refname")
+	if err != nil {
+		return "", err
+	}
+	for _, tag := range strings.Split(output, "\n") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			return tag, nil
+		}
+	}
+	return "", nil
+}
+
+func loadVersionsManifest(file string) (versionsManifest, error) {
+	manifest := versionsManifest{Snapshots: []versionSnapshot{}}
+	content, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return manifest, nil
+	}
+	if err != nil {
+		return manifest, err
+	}
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return manifest, fmt.Errorf("parse %s: %w", versionsManifestPath, err)
+	}
+	return manifest, nil
+}
+
+// register adds or replaces a snapshot, keeping the newest line first for version selectors.
+func (manifest *versionsManifest) register(snapshot versionSnapshot) {
+	kept := manifest.Snapshots[:0]
+	for _, existing := range manifest.Snapshots {
+		if existing.Version != snapshot.Version {
+			kept = append(kept, existing)
+		}
+	}
+	manifest.Snapshots = append(kept, snapshot)
+	sort.SliceStable(manifest.Snapshots, func(i, j int) bool {
+		return compareVersionLines(manifest.Snapshots[i].Version, manifest.Snapshots[j].Version) > 0
+	})
+}
+
+func compareVersionLines(a string, b string) int {
+	partsA, partsB := strings.Split(strings.TrimPrefix(a, "v"), "."), strings.Split(strings.TrimPrefix(b, "v"), ".")
+	for i := 0; i < len(partsA) && i < len(partsB); i++ {
+		numberA, _ := strconv.Atoi(partsA[i])
+		numberB, _ := strconv.Atoi(partsB[i])
+		if numberA != numberB {
+			return numberA - numberB
+		}
+	}
+	return len(partsA) - len(partsB)
+}
+
+func renderVersionsManifest(manifest versionsManifest) (string, error) {
+	content, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(content) + "\n", nil
+}
+
+// snapshotIndexPage is the landing page of a frozen line, linking to the first page of each copied section.
+func snapshotIndexPage(line string, release string, pages []string) string {
+	label := line
+	if release != "" {
+		label = release
+	}
+	var out strings.Builder
+	fmt.Fprintf(&out, "---\ntitle: GoForj %s\ndescription: %s\n---\n\n", line, strconv.Quote(fmt.Sprintf("Frozen GoForj documentation for the %s release line.", line)))
+	fmt.Fprintf(&out, "# GoForj %s\n\n", line)
+	fmt.Fprintf(&out, "This is a frozen copy of the documentation as of %s. The [current docs](/) follow the active development line.\n\n", label)
+	sections := map[string]struct{}{}
+	for _, page := range pages {
+		dir, _, nested := strings.Cut(page, "/")
+		if nested && strings.HasSuffix(page, "/index.md") && strings.Count(page, "/") == 1 {
+			sections[dir] = struct{}{}
+		}
+	}
+	names := make([]string, 0, len(sections))
+	for name := range sections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		title := strings.ReplaceAll(name, "-", " ")
+		fmt.Fprintf(&out, "- [%s](%s%s/)\n", strings.ToUpper(title[:1])+title[1:], snapshotRoute(line), name)
+	}
+	return out.String()
+}
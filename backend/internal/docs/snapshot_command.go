@@ -0,0 +1,163 @@
+package docs
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/goforj/docs/internal/logger"
+)
+
+// SnapshotCommand freezes the active docs as /versions/<line>/ and registers the line in the versions manifest.
+type SnapshotCommand struct {
+	Version   string   `arg:"" name:"version" help:"Release line to freeze, e.g. v0.24"`
+	Output    string   `name:"output" type:"path" env:"DOCS_OUTPUT_DIR" help:"Docs root to snapshot (defaults to ./docs or ../docs)"`
+	Framework string   `name:"framework" type:"path" env:"DOCS_FRAMEWORK_DIR" help:"Framework checkout whose tags and go.mod pin library versions (defaults to ../goforj beside the docs repo)"`
+	Library   []string `name:"library" help:"Pin a library explicitly as slug=tag, overriding the framework go.mod"`
+	CacheDir  string   `name:"cache-dir" type:"path" env:"DOCS_CACHE_DIR" help:"Directory holding the checkouts synced by docs:generate, whose go.mod files name each library module and feed dependency sections"`
+	Force     bool     `name:"force" help:"Replace an existing snapshot of the same line"`
+	logger    *logger.AppLogger
+}
+
+// NewDocsSnapshotCommand creates a new SnapshotCommand.
+func NewDocsSnapshotCommand(logger *logger.AppLogger) *SnapshotCommand {
+	return &SnapshotCommand{
+		logger: logger,
+	}
+}
+
+// Run copies framework pages into docs/versions/<line>/, renders each pinned library page from its tag alongside them
+// and records the snapshot in docs/public/versions.json. Libraries the release does not pin keep linking to the live pages.
+func (c *SnapshotCommand) Run() error {
+	if !snapshotLineRegex.MatchString(c.Version) {
+		return fmt.Errorf("snapshot version %q must be a release line like v0.24", c.Version)
+	}
+	docsRoot, err := resolveDocsRoot(c.Output)
+	if err != nil {
+		return err
+	}
+	repoRoot := filepath.Dir(docsRoot)
+	target := filepath.Join(docsRoot, filepath.FromSlash(snapshotDir(c.Version)))
+	if _, err := os.Stat(target); err == nil && !c.Force {
+		return fmt.Errorf("%s already exists; pass --force to replace it", path.Join(filepath.Base(docsRoot), snapshotDir(c.Version)))
+	}
+
+	cacheRoot, err := resolveCacheRoot(c.CacheDir)
+	if err != nil {
+		return err
+	}
+	repos := defaultRepos()
+	release, tags, err := c.libraryTags(repoRoot, cacheRoot, repos)
+	if err != nil {
+		return err
+	}
+	libraryPages := map[string]string{}
+	for _, repo := range repos {
+		tag, ok := tags[repo.Slug]
+		if !ok {
+			c.logger.Warn().Any("repo", repo.Slug).Msg("Library is not pinned for this release; snapshot links to the live page")
+			continue
+		}
+		page, err := renderPinnedLibraryPage(repo, tag, cacheRoot)
+		if err != nil {
+			return fmt.Errorf("render %s at %s: %w", repo.Slug, tag, err)
+		}
+		libraryPages[repo.Slug] = page
+		c.logger.Info().Any("repo", repo.Slug).Any("tag", tag).Msg("Rendered pinned library page")
+	}
+
+	site, err := loadDocsSite(docsRoot, libraryRewriteMap(repos))
+	if err != nil {
+		return err
+	}
+	files, err := buildSnapshot(site, c.Version, release, repos, libraryPages)
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(target); err != nil {
+		return fmt.Errorf("clear %s: %w", snapshotDir(c.Version), err)
+	}
+	for rel, content := range files {
+		file := filepath.Join(docsRoot, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			return err
+		}
+		if err := writeGeneratedPage(file, content); err != nil {
+			return fmt.Errorf("write %s: %w", rel, err)
+		}
+	}
+
+	manifestPath := filepath.Join(docsRoot, filepath.FromSlash(versionsManifestPath))
+	manifest, err := loadVersionsManifest(manifestPath)
+	if err != nil {
+		return err
+	}
+	manifest.register(versionSnapshot{
+		Version:   c.Version,
+		Release:   release,
+		Path:      snapshotRoute(c.Version),
+		Created:   time.Now().Format(time.DateOnly),
+		Libraries: tags,
+	})
+	rendered, err := renderVersionsManifest(manifest)
+	if err != nil {
+		return err
+	}
+	if err := writeGeneratedPage(manifestPath, rendered); err != nil {
+		return fmt.Errorf("write %s: %w", versionsManifestPath, err)
+	}
+	c.logger.Info().Any("version", c.Version).Any("release", release).Any("pages", len(files)).Any("libraries", len(tags)).Msg("Wrote docs snapshot")
+	return nil
+}
+
+// libraryTags resolves the newest framework tag on the line and the library versions its go.mod requires, then applies
+// --library overrides. Without a framework checkout only the overrides are pinned; without a synced checkout a library's
+// module path is unknown, so only an override can pin it.
+func (c *SnapshotCommand) libraryTags(repoRoot string, cacheRoot string, repos []RepoConfig) (string, map[string]string, error) {
+	frameworkDir := c.Framework
+	if frameworkDir == "" {
+		frameworkDir = siblingCheckout(repoRoot, "goforj")
+	}
+	release := ""
+	tags := map[string]string{}
+	if _, err := os.Stat(filepath.Join(frameworkDir, ".git")); err == nil {
+		release, err = latestLineTag(frameworkDir, c.Version)
+		if err != nil {
+			return "", nil, fmt.Errorf("list framework tags: %w", err)
+		}
+		if release == "" {
+			return "", nil, fmt.Errorf("framework checkout %s has no %s.* tag", frameworkDir, c.Version)
+		}
+		tags, err = frameworkLibraryTags(frameworkDir, release, repos, cacheRoot)
+		if err != nil {
+			return "", nil, err
+		}
+	} else {
+		c.logger.Warn().Any("framework", frameworkDir).Msg("No framework checkout; pinning only --library overrides")
+	}
+
+	known := map[string]struct{}{}
+	for _, repo := range repos {
+		known[repo.Slug] = struct{}{}
+	}
+	for _, override := range c.Library {
+		slug, tag, ok := strings.Cut(override, "=")
+		if !ok || tag == "" {
+			return "", nil, fmt.Errorf("--library %q must look like slug=tag", override)
+		}
+		if _, ok := known[slug]; !ok {
+			slugs := make([]string, 0, len(known))
+			for name := range known {
+				slugs = append(slugs, name)
+			}
+			sort.Strings(slugs)
+			return "", nil, fmt.Errorf("--library %q names an unknown library; known: %s", override, strings.Join(slugs, ", "))
+		}
+		tags[slug] = tag
+	}
+	return release, tags, nil
+}
@@ -0,0 +1,182 @@
+package docs
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestBuildSnapshotRewritesLinksAndPinsLibraries verifies framework links move under the version prefix, pinned
+// library pages are written from their rendered content and live-only pages keep their links.
+func TestBuildSnapshotRewritesLinksAndPinsLibraries(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	writeTestFiles(t, root, map[string]string{
+		"index.md":                 "# Home\n",
+		"blog/post.md":             "# Post\n",
+		"es/guide/index.md":        "# Guía\n",
+		"versions/index.md":        "# Versions\n",
+		"getting-started/index.md": "---\ntitle: Start\n---\n\nSee [setup](/getting-started/setup#install), [queues](/queue), [cache](/cache), [news](/blog/post) and [logo](/assets/logo.svg).\n\n```md\n[setup](/getting-started/setup)\n```\n\n<a href=\"/getting-started/setup\">Setup</a>\n",
+		"getting-started/setup.md": "# Setup\n\n## Install\n",
+		"libraries/queue.md":       "---\ntitle: Queue\n---\n\n# Queue from main\n",
+		"libraries/cache.md":       "# Cache\n",
+		"public/assets/logo.svg":   "<svg/>",
+	})
+	repos := []RepoConfig{
+		{Slug: "queue", CloneURL: "https://github.com/goforj/queue.git", OutputPath: filepath.Join("libraries", "queue.md")},
+		{Slug: "cache", CloneURL: "https://github.com/goforj/cache.git", OutputPath: filepath.Join("libraries", "cache.md")},
+	}
+	site, err := loadDocsSite(root, libraryRewriteMap(repos))
+	if err != nil {
+		t.Fatalf("loadDocsSite() error = %v", err)
+	}
+	pinned := "---\ntitle: Queue\nlatestTag: \"v1.3.0\"\neditLink: \"https://github.com/goforj/queue/edit/v1.3.0/README.md\"\n---\n\n# Queue\n\n[Source](https://github.com/goforj/queue/blob/v1.3.0/queue.go) [start](/getting-started/)\n"
+	files, err := buildSnapshot(site, "v0.24", "v0.24.1", repos, map[string]string{"queue": pinned})
+	if err != nil {
+		t.Fatalf("buildSnapshot() error = %v", err)
+	}
+
+	for _, want := range []string{"versions/v0.24/index.md", "versions/v0.24/getting-started/index.md", "versions/v0.24/getting-started/setup.md", "versions/v0.24/libraries/queue.md"} {
+		if _, ok := files[want]; !ok {
+			t.Fatalf("buildSnapshot() files = %v, want %s", keysOf(files), want)
+		}
+	}
+	if len(files) != 4 {
+		t.Fatalf("buildSnapshot() files = %v, want only framework pages, pinned libraries and the index", keysOf(files))
+	}
+
+	start := files["versions/v0.24/getting-started/index.md"]
+	for _, want := range []string{
+		"[setup](/versions/v0.24/getting-started/setup#install)",
+		"[queues](/versions/v0.24/libraries/queue)",
+		"[cache](/cache)",
+		"[news](/blog/post)",
+		"[logo](/assets/logo.svg)",
+		"```md\n[setup](/getting-started/setup)\n```",
+		`<a href="/versions/v0.24/getting-started/setup">`,
+	} {
+		if !strings.Contains(start, want) {
+			t.Fatalf("buildSnapshot() page =\n%s\nwant %q", start, want)
+		}
+	}
+
+	queue := files["versions/v0.24/libraries/queue.md"]
+	want := "---\ntitle: Queue\nlatestTag: \"v1.3.0\"\n---\n\n# Queue\n\n[Source](https://github.com/goforj/queue/blob/v1.3.0/queue.go) [start](/versions/v0.24/getting-started/)\n"
+	if queue != want {
+		t.Fatalf("buildSnapshot() library page =\n%s\nwant\n%s", queue, want)
+	}
+	if index := files["versions/v0.24/index.md"]; !strings.Contains(index, "as of v0.24.1") || !strings.Contains(index, "[Getting started](/versions/v0.24/getting-started/)") {
+		t.Fatalf("snapshotIndexPage() =\n%s\nwant release label and section link", index)
+	}
+}
+
+// TestRenderPinnedLibraryPageReadsTag verifies the pinned page is rendered from the tag's README with the tag's commit
+// and stats, not from the branch the live page follows.
+func TestRenderPinnedLibraryPageReadsTag(t *testing.T) {
+	t.Parallel()
+
+	source := t.TempDir()
+	runTestGit(t, source, "init", "--quiet", "--initial-branch=main")
+	runTestGit(t, source, "config", "uploadpack.allowFilter", "true")
+	writeTestFiles(t, source, map[string]string{
+		"README.md":     "# Queue\n\nReleased behavior.\n\n![Logo](docs/logo.png)\n",
+		"docs/logo.png": "png",
+		"go.mod":        "module github.com/goforj/queue\n\ngo 1.24\n",
+		"queue.go":      "package queue\n\nfunc New() {}\n",
+		"queue_test.go": "package queue\n\nimport \"testing\"\n\nfunc TestNew(t *testing.T) {}\n",
+	})
+	runTestGit(t, source, "add", ".")
+	runTestGit(t, source, "commit", "--quiet", "-m", "v1.3.0")
+	runTestGit(t, source, "tag", "v1.3.0")
+	tagCommit, err := gitOutput(source, "rev-parse", "HEAD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tagCommit = strings.TrimSpace(tagCommit)
+	writeTestFiles(t, source, map[string]string{"README.md": "# Queue\n\nUnreleased behavior.\n"})
+	runTestGit(t, source, "commit", "--quiet", "-am", "unreleased")
+
+	repo := RepoConfig{Slug: "queue", Title: "Queue", Description: "Queues.", CloneURL: "file://" + source, Branch: "main", OutputPath: filepath.Join("libraries", "queue.md")}
+	page, err := renderPinnedLibraryPage(repo, "v1.3.0", t.TempDir())
+	if err != nil {
+		t.Fatalf("renderPinnedLibraryPage() error = %v", err)
+	}
+	for _, want := range []string{
+		"Released behavior.",
+		`latestTag: "v1.3.0"`,
+		"sourceCommit: " + strconv.Quote(tagCommit),
+		"testFunctions: 1\n",
+		"v1.3.0/docs/logo.png",
+	} {
+		if !strings.Contains(page, want) {
+			t.Fatalf("renderPinnedLibraryPage() =\n%s\nwant %q", page, want)
+		}
+	}
+	if strings.Contains(page, "Unreleased behavior.") {
+		t.Fatalf("renderPinnedLibraryPage() =\n%s\nwant the branch text left out", page)
+	}
+}
+
+// TestFrameworkLibraryTagsReadsReleaseGoMod verifies the newest tag on the line is picked and its go.mod pins libraries
+// by the module path each checkout declares rather than the clone URL.
+func TestFrameworkLibraryTagsReadsReleaseGoMod(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	runTestGit(t, dir, "init", "-q")
+	goMod := "module github.com/goforj/goforj\n\ngo 1.24\n\nrequire (\n\tgithub.com/goforj/queue v1.2.0\n\tgoforj.dev/cache v0.9.1\n\tgithub.com/goforj/mail v0.4.0\n)\n"
+	writeTestFiles(t, dir, map[string]string{"go.mod": goMod})
+	runTestGit(t, dir, "add", "go.mod")
+	runTestGit(t, dir, "commit", "-q", "-m", "v0.24.0")
+	runTestGit(t, dir, "tag", "v0.24.0")
+	writeTestFiles(t, dir, map[string]string{"go.mod": strings.Replace(goMod, "queue v1.2.0", "queue v1.3.0", 1)})
+	runTestGit(t, dir, "commit", "-q", "-am", "v0.24.1")
+	runTestGit(t, dir, "tag", "v0.24.1")
+	runTestGit(t, dir, "tag", "v0.25.0")
+
+	tag, err := latestLineTag(dir, "v0.24")
+	if err != nil || tag != "v0.24.1" {
+		t.Fatalf("latestLineTag() = %q, %v, want v0.24.1", tag, err)
+	}
+	repos := []RepoConfig{
+		{Slug: "queue", CloneURL: "https://github.com/goforj/queue.git"},
+		{Slug: "cache", CloneURL: "https://github.com/goforj/cache.git"},
+		{Slug: "mail", CloneURL: "https://github.com/goforj/mail.git"},
+	}
+	cacheRoot := t.TempDir()
+	writeTestFiles(t, cacheRoot, map[string]string{
+		"queue/go.mod": "module github.com/goforj/queue\n",
+		"cache/go.mod": "module goforj.dev/cache\n",
+	})
+	tags, err := frameworkLibraryTags(dir, tag, repos, cacheRoot)
+	if err != nil {
+		t.Fatalf("frameworkLibraryTags() error = %v", err)
+	}
+	if len(tags) != 2 || tags["queue"] != "v1.3.0" || tags["cache"] != "v0.9.1" {
+		t.Fatalf("frameworkLibraryTags() = %v, want queue v1.3.0 and cache v0.9.1", tags)
+	}
+}
+
+// TestVersionsManifestRegisterKeepsNewestFirst verifies re-snapshotting a line replaces its entry in place.
+func TestVersionsManifestRegisterKeepsNewestFirst(t *testing.T) {
+	t.Parallel()
+
+	manifest := versionsManifest{}
+	manifest.register(versionSnapshot{Version: "v0.9", Path: snapshotRoute("v0.9")})
+	manifest.register(versionSnapshot{Version: "v0.24", Path: snapshotRoute("v0.24")})
+	manifest.register(versionSnapshot{Version: "v0.9", Path: snapshotRoute("v0.9"), Release: "v0.9.3"})
+
+	if len(manifest.Snapshots) != 2 || manifest.Snapshots[0].Version != "v0.24" || manifest.Snapshots[1].Release != "v0.9.3" {
+		t.Fatalf("register() = %+v, want v0.24 then the replaced v0.9", manifest.Snapshots)
+	}
+}
+
+func keysOf(files map[string]string) []string {
+	keys := make([]string, 0, len(files))
+	for key := range files {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,305 @@
+package docs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var goFenceRegex = regexp.MustCompile("^\\s*(```+|~~~+)\\s*(go|golang)(?:\\s+(.*))?$")
+
+// snippetSkipMarker lets README authors opt a deliberately partial fence out of verification without changing its highlighting.
+const snippetSkipMarker = "no-verify"
+
+// goSnippet is one fenced Go block; Line is the README line of the first code line.
+type goSnippet struct {
+	Line int
+	Code string
+	Skip bool
+}
+
+// snippetProblem is a verification failure already mapped back to README coordinates.
+type snippetProblem struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+// extractGoSnippets reads only go/golang fences so shell, text and go.mod samples are never compiled.
+func extractGoSnippets(readme string) []goSnippet {
+	var snippets []goSnippet
+	lines := strings.Split(readme, "\n")
+	for i := 0; i < len(lines); i++ {
+		matches := goFenceRegex.FindStringSubmatch(lines[i])
+		if matches == nil {
+			if fence := strings.TrimSpace(lines[i]); strings.HasPrefix(fence, "```") || strings.HasPrefix(fence, "~~~") {
+				i = skipFence(lines, i)
+			}
+			continue
+		}
+
+		fence := matches[1]
+		var body []string
+		end := i + 1
+		for ; end < len(lines); end++ {
+			if strings.HasPrefix(strings.TrimSpace(lines[end]), fence) {
+				break
+			}
+			body = append(body, lines[end])
+		}
+		snippets = append(snippets, goSnippet{
+			Line: i + 2,
+			Code: strings.Join(body, "\n"),
+			Skip: strings.Contains(matches[3], snippetSkipMarker),
+		})
+		i = end
+	}
+	return snippets
+}
+
+// skipFence returns the closing line of a non-Go fence so Go fences quoted inside Markdown samples are ignored.
+func skipFence(lines []string, open int) int {
+	trimmed := strings.TrimSpace(lines[open])
+	marker := trimmed[:len(trimmed)-len(strings.TrimLeft(trimmed, trimmed[:1]))]
+	for i := open + 1; i < len(lines); i++ {
+		if strings.HasPrefix(strings.TrimSpace(lines[i]), marker) {
+			return i
+		}
+	}
+	return len(lines)
+}
+
+// scaffoldSnippet wraps fragments as little as possible: complete files are untouched, bare declarations gain a package
+// clause, and statements move into func main with their imports hoisted. //line directives keep positions on README lines.
+func scaffoldSnippet(snippet goSnippet, readmePath string) (string, bool) {
+	directive := func(line int) string {
+		return fmt.Sprintf("//line %s:%d\n", readmePath, line)
+	}
+
+	fset := token.NewFileSet()
+	if _, err := parser.ParseFile(fset, "", snippet.Code, parser.PackageClauseOnly); err == nil {
+		return directive(snippet.Line) + snippet.Code + "\n", false
+	}
+	if _, err := parser.ParseFile(fset, "", "package main\n"+snippet.Code, parser.AllErrors); err == nil {
+		return "package main\n\n" + directive(snippet.Line) + snippet.Code + "\n", true
+	}
+
+	lines := strings.Split(snippet.Code, "\n")
+	split := importPreambleEnd(lines)
+	var out strings.Builder
+	out.WriteString("package main\n\n")
+	if split > 0 {
+		out.WriteString(directive(snippet.Line))
+		out.WriteString(strings.Join(lines[:split], "\n"))
+		out.WriteString("\n")
+	}
+	out.WriteString("\nfunc main() {\n")
+	out.WriteString(directive(snippet.Line + split))
+	out.WriteString(strings.Join(lines[split:], "\n"))
+	out.WriteString("\n}\n")
+	return out.String(), true
+}
+
+// importPreambleEnd finds the end of the leading import declarations in a statement fragment.
+func importPreambleEnd(lines []string) int {
+	end := 0
+	inBlock := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case inBlock:
+			if trimmed == ")" {
+				inBlock = false
+				end = i + 1
+			}
+		case strings.HasPrefix(trimmed, "import ("):
+			inBlock = true
+		case strings.HasPrefix(trimmed, "import "):
+			end = i + 1
+		case trimmed == "" || strings.HasPrefix(trimmed, "//"):
+			continue
+		default:
+			return end
+		}
+	}
+	return end
+}
+
+// snippetGoEnv keeps imports resolving from the populated module cache; a proxy fetch would make results depend on the network.
+var snippetGoEnv = []string{"GOPROXY=off", "GOWORK=off"}
+
+// snippetChecker type-checks snippets from source against the checkout module. One importer is shared per checkout so
+// dependencies are loaded once; the module cache must already hold them because imports resolve without a proxy.
+type snippetChecker struct {
+	importer types.ImporterFrom
+	fset     *token.FileSet
+}
+
+func newSnippetChecker(dir string) *snippetChecker {
+	fset := token.NewFileSet()
+	context := build.Default
+	context.Dir = dir
+	context.CgoEnabled = false
+	return &snippetChecker{
+		importer: &sourceImporter{dir: dir, context: &context, fset: fset, packages: map[string]*types.Package{}},
+		fset:     fset,
+	}
+}
+
+// sourceImporter type-checks imported packages from source like go/importer's "source" importer. That importer reads
+// build.Default and runs go list with the process environment, so module imports are resolved here instead, with the
+// checkout directory and snippetGoEnv scoped to this importer. Cgo is off so packages fall back to their pure Go files.
+type sourceImporter struct {
+	dir      string
+	context  *build.Context
+	fset     *token.FileSet
+	packages map[string]*types.Package
+}
+
+func (s *sourceImporter) Import(path string) (*types.Package, error) {
+	return s.ImportFrom(path, s.dir, 0)
+}
+
+func (s *sourceImporter) ImportFrom(path string, srcDir string, _ types.ImportMode) (*types.Package, error) {
+	if path == "unsafe" {
+		return types.Unsafe, nil
+	}
+	dir, importPath, err := s.find(path, srcDir)
+	if err != nil {
+		return nil, err
+	}
+	if pkg, ok := s.packages[importPath]; ok {
+		if pkg == nil {
+			return nil, fmt.Errorf("import cycle through package %q", importPath)
+		}
+		return pkg, nil
+	}
+
+	buildPkg, err := s.context.ImportDir(dir, 0)
+	if err != nil {
+		return nil, fmt.Errorf("import %q: %w", importPath, err)
+	}
+	files := make([]*ast.File, 0, len(buildPkg.GoFiles))
+	for _, name := range buildPkg.GoFiles {
+		file, err := parser.ParseFile(s.fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("import %q: %w", importPath, err)
+		}
+		files = append(files, file)
+	}
+
+	s.packages[importPath] = nil
+	var firstErr error
+	config := types.Config{
+		Importer:         s,
+		IgnoreFuncBodies: true,
+		Error: func(err error) {
+			if firstErr == nil {
+				firstErr = err
+			}
+		},
+	}
+	pkg, _ := config.Check(importPath, s.fset, files, nil)
+	if firstErr != nil {
+		delete(s.packages, importPath)
+		return nil, fmt.Errorf("type-check %q: %w", importPath, firstErr)
+	}
+	s.packages[importPath] = pkg
+	return pkg, nil
+}
+
+// find leaves standard library and GOROOT-vendored imports to go/build, which resolves them without running go list,
+// and asks go list in the checkout for everything else.
+func (s *sourceImporter) find(path string, srcDir string) (string, string, error) {
+	goroot := filepath.Join(s.context.GOROOT, "src")
+	if info, err := os.Stat(filepath.Join(goroot, filepath.FromSlash(path))); (err == nil && info.IsDir()) || isSubdir(goroot, srcDir) {
+		buildPkg, err := s.context.Import(path, srcDir, build.FindOnly)
+		if err != nil {
+			return "", "", err
+		}
+		return buildPkg.Dir, buildPkg.ImportPath, nil
+	}
+
+	cmd := exec.Command("go", "list", "-e", "-f", "{{.Dir}}\n{{.ImportPath}}\n{{if .Error}}{{.Error}}{{end}}", "--", path)
+	cmd.Dir = s.dir
+	cmd.Env = append(os.Environ(), snippetGoEnv...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return "", "", fmt.Errorf("go list %s: %w: %s", path, err, strings.TrimSpace(stderr.String()))
+	}
+	fields := strings.SplitN(string(output), "\n", 3)
+	if len(fields) != 3 {
+		return "", "", fmt.Errorf("go list %s: unexpected output %q", path, output)
+	}
+	if fields[0] == "" {
+		return "", "", fmt.Errorf("go list %s: %s", path, strings.TrimSpace(fields[2]))
+	}
+	return fields[0], fields[1], nil
+}
+
+func isSubdir(root string, dir string) bool {
+	rel, err := filepath.Rel(root, dir)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// check reports parse and type errors; wrapped fragments tolerate unused names because README excerpts rarely use every result.
+func (c *snippetChecker) check(snippet goSnippet, readmePath string, sourcePath string) []snippetProblem {
+	source, wrapped := scaffoldSnippet(snippet, readmePath)
+	file, err := parser.ParseFile(c.fset, sourcePath, source, parser.ParseComments|parser.AllErrors)
+	if err != nil {
+		return c.parseProblems(err)
+	}
+
+	var problems []snippetProblem
+	config := types.Config{
+		Importer: c.importer,
+		Error: func(err error) {
+			var typeErr types.Error
+			if !errors.As(err, &typeErr) {
+				problems = append(problems, snippetProblem{Line: snippet.Line, Message: err.Error()})
+				return
+			}
+			if wrapped && isUnusedError(typeErr.Msg) {
+				return
+			}
+			position := c.fset.Position(typeErr.Pos)
+			problems = append(problems, snippetProblem{Line: position.Line, Column: position.Column, Message: typeErr.Msg})
+		},
+	}
+	_, _ = config.Check(file.Name.Name, c.fset, []*ast.File{file}, nil)
+	sort.SliceStable(problems, func(i, j int) bool {
+		return problems[i].Line < problems[j].Line
+	})
+	return problems
+}
+
+// parseProblems keeps only the first syntax error because later ones are usually cascades from the same mistake.
+func (c *snippetChecker) parseProblems(err error) []snippetProblem {
+	var list scanner.ErrorList
+	if !errors.As(err, &list) || len(list) == 0 {
+		return []snippetProblem{{Message: err.Error()}}
+	}
+	first := list[0]
+	return []snippetProblem{{Line: first.Pos.Line, Column: first.Pos.Column, Message: first.Msg}}
+}
+
+func isUnusedError(message string) bool {
+	return strings.HasSuffix(message, "declared and not used") ||
+		strings.Contains(message, "declared and not used:") ||
+		strings.HasSuffix(message, "imported and not used") ||
+		strings.Contains(message, "imported and not used")
+}
@@ -0,0 +1,101 @@
+package docs
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestExtractGoSnippets verifies only Go fences are collected, with README line numbers and skip markers.
+func TestExtractGoSnippets(t *testing.T) {
+	t.Parallel()
+
+	readme := strings.Join([]string{
+		"# Cache",
+		"```bash",
+		"go get github.com/goforj/cache",
+		"```",
+		"```go",
+		"c := cache.New()",
+		"```",
+		"````md",
+		"```go",
+		"ignored := true",
+		"```",
+		"````",
+		"```golang no-verify",
+		"partial(...)",
+		"```",
+	}, "\n")
+
+	got := extractGoSnippets(readme)
+	if len(got) != 2 {
+		t.Fatalf("extractGoSnippets() returned %d snippets, want 2: %#v", len(got), got)
+	}
+	if got[0].Line != 6 || got[0].Code != "c := cache.New()" || got[0].Skip {
+		t.Fatalf("extractGoSnippets()[0] = %#v", got[0])
+	}
+	if got[1].Line != 14 || !got[1].Skip {
+		t.Fatalf("extractGoSnippets()[1] = %#v, want skipped snippet on line 14", got[1])
+	}
+}
+
+// TestScaffoldSnippet verifies complete files, declarations and statement fragments are wrapped appropriately.
+func TestScaffoldSnippet(t *testing.T) {
+	t.Parallel()
+
+	file, wrapped := scaffoldSnippet(goSnippet{Line: 3, Code: "package demo\n\nfunc A() {}"}, "/repo/README.md")
+	if wrapped || !strings.HasPrefix(file, "//line /repo/README.md:3\npackage demo") {
+		t.Fatalf("scaffoldSnippet() wrapped a complete file:\n%s", file)
+	}
+
+	decls, wrapped := scaffoldSnippet(goSnippet{Line: 5, Code: "func A() {}"}, "/repo/README.md")
+	if !wrapped || !strings.HasPrefix(decls, "package main\n\n//line /repo/README.md:5\nfunc A() {}") {
+		t.Fatalf("scaffoldSnippet() declarations =\n%s", decls)
+	}
+
+	statements, _ := scaffoldSnippet(goSnippet{Line: 10, Code: "import \"fmt\"\n\nfmt.Println(1)"}, "/repo/README.md")
+	for _, want := range []string{"//line /repo/README.md:10\nimport \"fmt\"", "func main() {\n//line /repo/README.md:11\n\nfmt.Println(1)\n}"} {
+		if !strings.Contains(statements, want) {
+			t.Fatalf("scaffoldSnippet() statements missing %q:\n%s", want, statements)
+		}
+	}
+}
+
+// TestVerifyCheckoutSnippets verifies type errors are reported against README lines and fragments against the checkout module.
+func TestVerifyCheckoutSnippets(t *testing.T) {
+	root := t.TempDir()
+	writeTestFiles(t, root, map[string]string{
+		"go.mod":   "module example.com/greet\n\ngo 1.21\n",
+		"greet.go": "package greet\n\n// Hello greets name.\nfunc Hello(name string) string { return \"hello \" + name }\n",
+		"README.md": strings.Join([]string{
+			"# Greet",
+			"",
+			"```go",
+			"import \"example.com/greet\"",
+			"",
+			"message := greet.Hello(\"docs\")",
+			"```",
+			"",
+			"```go",
+			"import \"example.com/greet\"",
+			"",
+			"var count int = greet.Hello(\"docs\")",
+			"```",
+		}, "\n"),
+	})
+	t.Setenv("GOFLAGS", "-mod=mod")
+
+	var out bytes.Buffer
+	checked, failed, err := verifyCheckoutSnippets(&out, repoCheckout{Repo: RepoConfig{Slug: "greet"}, Dir: root})
+	if err != nil {
+		t.Fatalf("verifyCheckoutSnippets() error = %v", err)
+	}
+	if checked != 2 || failed != 1 {
+		t.Fatalf("verifyCheckoutSnippets() = %d checked, %d failed; want 2, 1\n%s", checked, failed, out.String())
+	}
+	if !strings.HasPrefix(out.String(), filepath.ToSlash("greet/README.md:12:")) || !strings.Contains(out.String(), "cannot use") {
+		t.Fatalf("verifyCheckoutSnippets() output = %q, want type error on README line 12", out.String())
+	}
+}
@@ -0,0 +1,223 @@
+package docs
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// sparseDocsPatterns keep what docs:generate reads besides page sources: go.mod files for the dependency graph, example
+// programs for the catalog and root metadata files. Page-specific files are added on top.
+func sparseDocsPatterns() []string {
+	patterns := []string{"go.mod", "/examples/*/main.go", sparsePattern(repoDocsConfigPath)}
+	for _, name := range licenseFileNames {
+		patterns = append(patterns, sparsePattern(name))
+	}
+	for _, name := range changelogFileNames {
+		patterns = append(patterns, sparsePattern(name))
+	}
+	return patterns
+}
+
+// sparseSourcePatterns are the Go toolchain inputs that stats, API pages, drivers and snippet checks load. They are only
+// added when one of those needs them, because in large repos they are most of the tree.
+var sparseSourcePatterns = []string{"*.go", "go.sum", "go.work", "go.work.sum", "*.s", "*.c", "*.h"}
+
+// sparseCloneRepo syncs a blob-filtered, sparse checkout holding only the files generated pages need. Existing full
+// checkouts are updated in place so switching modes never forces a reclone, and a checkout another command widened to
+// its Go sources is narrowed back so the cache stays docs-sized.
+func sparseCloneRepo(repo RepoConfig, dest string, auth gitAuth) (string, error) {
+	action := "cloned sparse"
+	if isGitRepo(dest) {
+		if !isSparseCheckout(dest) {
+			return cloneRepo(repo.CloneURL, dest, repo.Branch, auth)
+		}
+		if err := updateRepo(dest, repo.Branch, auth); err != nil {
+			return "", fmt.Errorf("update repo: %w", err)
+		}
+		action = "updated sparse"
+	} else {
+		if err := os.RemoveAll(dest); err != nil {
+			return "", fmt.Errorf("clean repo dir: %w", err)
+		}
+		args := []string{"clone", "--depth", "1", "--filter=blob:none", "--no-checkout"}
+		if repo.Branch != "" {
+			args = append(args, "--branch", repo.Branch)
+		}
+		if _, err := runGit(auth, append(args, repo.CloneURL, dest)...); err != nil {
+			return "", err
+		}
+		if err := setSparsePatterns(dest, auth, sparseDocsPatterns()); err != nil {
+			return "", err
+		}
+		if _, err := runGit(auth, "-C", dest, "checkout", "--quiet", "HEAD"); err != nil {
+			return "", err
+		}
+	}
+	if err := refreshSparsePatterns(repo, dest, auth, false); err != nil {
+		return "", err
+	}
+	return action, nil
+}
+
+// ensureSparseSources widens a sparse cache checkout to its Go sources. Full checkouts and local sources already have them.
+// The fetched blobs stay in the object store, so narrowing and widening again later costs no network.
+func ensureSparseSources(repo RepoConfig, dir string, auth gitAuth) error {
+	if !isGitRepo(dir) || !isSparseCheckout(dir) || hasSparseSources(dir) {
+		return nil
+	}
+	if err := refreshSparsePatterns(repo, dir, auth, true); err != nil {
+		return fmt.Errorf("check out Go sources for %s: %w", repo.Slug, err)
+	}
+	return nil
+}
+
+// hasSparseSources reports whether the checkout's sparse patterns already include the Go sources.
+func hasSparseSources(dir string) bool {
+	output, err := gitOutput(dir, "sparse-checkout", "list")
+	if err != nil {
+		return false
+	}
+	for _, line := range strings.Split(output, "\n") {
+		if strings.TrimSpace(line) == sparseSourcePatterns[0] {
+			return true
+		}
+	}
+	return false
+}
+
+// narrowSparseSources drops the Go sources a count needed, returning a sparse cache checkout to its docs patterns.
+func narrowSparseSources(repo RepoConfig, dir string, auth gitAuth) error {
+	if !isGitRepo(dir) || !isSparseCheckout(dir) || !hasSparseSources(dir) {
+		return nil
+	}
+	if err := refreshSparsePatterns(repo, dir, auth, false); err != nil {
+		return fmt.Errorf("drop Go sources for %s: %w", repo.Slug, err)
+	}
+	return nil
+}
+
+// refreshSparsePatterns widens the checkout until the README, docs.yaml pages, their includes and images are all present.
+// Each round can only discover files included by the previous one, so the loop is bounded by the include depth.
+func refreshSparsePatterns(repo RepoConfig, dest string, auth gitAuth, sources bool) error {
+	patterns := sparseDocsPatterns()
+	if sources {
+		patterns = append(patterns, sparseSourcePatterns...)
+	}
+	var current []string
+	for round := 0; round <= maxIncludeDepth+1; round++ {
+		files, err := pageSourceFiles(repo, dest)
+		if err != nil {
+			return err
+		}
+		next := append(append([]string{}, patterns...), sparseFilePatterns(files)...)
+		if round > 0 && strings.Join(next, "\n") == strings.Join(current, "\n") {
+			return nil
+		}
+		if err := setSparsePatterns(dest, auth, next); err != nil {
+			return err
+		}
+		current = next
+	}
+	return fmt.Errorf("sparse checkout for %s did not settle after %d rounds", repo.Slug, maxIncludeDepth+1)
+}
+
+func setSparsePatterns(dest string, auth gitAuth, patterns []string) error {
+	_, err := runGitInput(auth, strings.Join(patterns, "\n")+"\n", "-C", dest, "sparse-checkout", "set", "--no-cone", "--stdin")
+	return err
+}
+
+func isSparseCheckout(dir string) bool {
+	output, err := gitOutput(dir, "config", "--bool", "core.sparseCheckout")
+	return err == nil && strings.TrimSpace(output) == "true"
+}
+
+// pageSourceFiles lists the repo-relative files page generation reads: the README and its translations, docs.yaml pages, files they include
+// and the local images referenced from any of them. Files not checked out yet are listed without being followed.
+func pageSourceFiles(repo RepoConfig, dir string) ([]string, error) {
+	repo, err := applyRepoDocsConfig(repo, dir)
+	if err != nil {
+		return nil, err
+	}
+	readmePath := repo.ReadmePath
+	if readmePath == "" {
+		readmePath = "README.md"
+	}
+	queue := []string{path.Clean(readmePath)}
+	for _, lang := range translationLanguages() {
+		queue = append(queue, path.Clean(localizedReadmePath(readmePath, lang)))
+	}
+	for _, page := range repo.Pages {
+		queue = append(queue, path.Clean(page.Source))
+	}
+
+	seen := map[string]struct{}{}
+	for len(queue) > 0 {
+		file := queue[0]
+		queue = queue[1:]
+		if _, ok := seen[file]; ok {
+			continue
+		}
+		seen[file] = struct{}{}
+		if !strings.HasSuffix(strings.ToLower(file), ".md") {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(file)))
+		if err != nil {
+			continue
+		}
+		sourceDir := sourceDirOf(file)
+		queue = append(queue, includeTargets(string(content), sourceDir)...)
+		queue = append(queue, localImagePaths(string(content), sourceDir)...)
+	}
+
+	files := make([]string, 0, len(seen))
+	for file := range seen {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// localImagePaths resolves the same image references rewriteImageLinks points at raw URLs.
+func localImagePaths(content string, sourceDir string) []string {
+	var paths []string
+	for _, pattern := range []*regexp.Regexp{markdownImageRegex, htmlImageRegex} {
+		for _, match := range pattern.FindAllStringSubmatch(content, -1) {
+			target := strings.TrimSpace(match[1])
+			if fields := strings.Fields(target); len(fields) > 0 {
+				target = fields[0]
+			}
+			lower := strings.ToLower(target)
+			if target == "" || strings.HasPrefix(target, "#") || strings.Contains(lower, "://") || strings.HasPrefix(lower, "data:") {
+				continue
+			}
+			if cut := strings.IndexAny(target, "?#"); cut >= 0 {
+				target = target[:cut]
+			}
+			resolved := path.Clean(repoRelativePath(target, sourceDir))
+			if isRepoLocalPath(resolved) {
+				paths = append(paths, resolved)
+			}
+		}
+	}
+	return paths
+}
+
+// sparseFilePatterns anchors each file at the repository root and escapes gitignore syntax in its name.
+func sparseFilePatterns(files []string) []string {
+	patterns := make([]string, 0, len(files))
+	for _, file := range files {
+		patterns = append(patterns, sparsePattern(file))
+	}
+	return patterns
+}
+
+func sparsePattern(file string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`, `[`, `\[`, `!`, `\!`, `#`, `\#`).Replace(file)
+	return "/" + escaped
+}
@@ -0,0 +1,178 @@
+package docs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/goforj/docs/internal/logger"
+)
+
+// TestSparseCloneRepoFetchesOnlyPageInputs verifies the checkout holds the README, includes and images but not Go sources
+// or unrelated assets, and that a later update narrows a checkout widened to its Go sources.
+func TestSparseCloneRepoFetchesOnlyPageInputs(t *testing.T) {
+	t.Parallel()
+
+	source := t.TempDir()
+	runTestGit(t, source, "init", "--quiet", "--initial-branch=main")
+	runTestGit(t, source, "config", "uploadpack.allowFilter", "true")
+	writeTestFiles(t, source, map[string]string{
+		"README.md":               "# Cache\n\n![Logo](docs/img/logo.png)\n\n<!--@include: ./docs/parts/usage.md-->\n",
+		"docs/parts/usage.md":     "## Usage\n\n<img src=\"../img/flow.svg\">\n\n<!--@include: nested.md-->\n",
+		"docs/parts/nested.md":    "Nested part.\n",
+		"docs/img/logo.png":       "png",
+		"docs/img/flow.svg":       "svg",
+		"docs/img/unused.png":     "unused",
+		"website/index.html":      "<html></html>",
+		"go.mod":                  "module github.com/goforj/cache\n\ngo 1.23\n",
+		"cache.go":                "package cache\n",
+		"driver/redis/redis.go":   "package redis\n",
+		"driver/redis/go.mod":     "module github.com/goforj/cache/driver/redis\n\ngo 1.23\n",
+		"examples/basic/main.go":  "package main\n\nfunc main() {}\n",
+		"LICENSE":                 "MIT License\n",
+		".goforj/docs.yaml":       "pages:\n  - source: docs/drivers.md\n    slug: drivers\n    title: Drivers\n",
+		"docs/drivers.md":         "# Drivers\n\n![Matrix](matrix.png)\n",
+		"docs/matrix.png":         "matrix",
+		"testdata/large/blob.bin": "large",
+	})
+	runTestGit(t, source, "add", ".")
+	runTestGit(t, source, "commit", "--quiet", "-m", "initial")
+
+	dest := filepath.Join(t.TempDir(), "cache")
+	repo := RepoConfig{Slug: "cache", CloneURL: "file://" + source, Branch: "main"}
+	action, err := sparseCloneRepo(repo, dest, gitAuth{})
+	if err != nil {
+		t.Fatalf("sparseCloneRepo() error = %v", err)
+	}
+	if action != "cloned sparse" {
+		t.Fatalf("sparseCloneRepo() action = %q, want cloned sparse", action)
+	}
+	for _, want := range []string{"README.md", "docs/parts/usage.md", "docs/parts/nested.md", "docs/img/logo.png", "docs/img/flow.svg", "docs/drivers.md", "docs/matrix.png", "go.mod", "driver/redis/go.mod", "examples/basic/main.go", "LICENSE", ".goforj/docs.yaml"} {
+		if _, err := os.Stat(filepath.Join(dest, want)); err != nil {
+			t.Fatalf("sparse checkout missing %s: %v", want, err)
+		}
+	}
+	for _, unwanted := range []string{"cache.go", "driver/redis/redis.go", "docs/img/unused.png", "website/index.html", "testdata/large/blob.bin"} {
+		if _, err := os.Stat(filepath.Join(dest, unwanted)); err == nil {
+			t.Fatalf("sparse checkout should not contain %s", unwanted)
+		}
+	}
+
+	if err := ensureSparseSources(repo, dest, gitAuth{}); err != nil {
+		t.Fatalf("ensureSparseSources() error = %v", err)
+	}
+	for _, want := range []string{"cache.go", "driver/redis/redis.go"} {
+		if _, err := os.Stat(filepath.Join(dest, want)); err != nil {
+			t.Fatalf("widened sparse checkout missing Go source %s: %v", want, err)
+		}
+	}
+
+	writeTestFiles(t, source, map[string]string{"README.md": "# Cache\n\n![Unused](docs/img/unused.png)\n"})
+	runTestGit(t, source, "commit", "--quiet", "-am", "swap image")
+	if action, err := sparseCloneRepo(repo, dest, gitAuth{}); err != nil || action != "updated sparse" {
+		t.Fatalf("sparseCloneRepo() update = %q, %v; want updated sparse", action, err)
+	}
+	if _, err := os.Stat(filepath.Join(dest, "docs/img/unused.png")); err != nil {
+		t.Fatalf("updated sparse checkout missing newly referenced image: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dest, "docs/img/logo.png")); err == nil {
+		t.Fatal("updated sparse checkout kept an image the README no longer references")
+	}
+	for _, unwanted := range []string{"cache.go", "driver/redis/redis.go"} {
+		if _, err := os.Stat(filepath.Join(dest, unwanted)); err == nil {
+			t.Fatalf("updated sparse checkout kept Go source %s", unwanted)
+		}
+	}
+}
+
+// TestLibraryStatsNarrowsSparseCheckout verifies counting widens a docs-only checkout just long enough to read its Go files.
+func TestLibraryStatsNarrowsSparseCheckout(t *testing.T) {
+	t.Parallel()
+
+	source := t.TempDir()
+	runTestGit(t, source, "init", "--quiet", "--initial-branch=main")
+	runTestGit(t, source, "config", "uploadpack.allowFilter", "true")
+	writeTestFiles(t, source, map[string]string{
+		"README.md":     "# Cache\n",
+		"go.mod":        "module github.com/goforj/cache\n\ngo 1.24\n",
+		"cache.go":      "package cache\n\nfunc New() {}\n",
+		"cache_test.go": "package cache\n\nimport \"testing\"\n\nfunc TestNew(t *testing.T) {}\n",
+	})
+	runTestGit(t, source, "add", ".")
+	runTestGit(t, source, "commit", "--quiet", "-m", "initial")
+
+	dest := filepath.Join(t.TempDir(), "cache")
+	repo := RepoConfig{Slug: "cache", CloneURL: "file://" + source, Branch: "main", OutputPath: filepath.Join("libraries", "cache.md")}
+	if _, err := sparseCloneRepo(repo, dest, gitAuth{}); err != nil {
+		t.Fatalf("sparseCloneRepo() error = %v", err)
+	}
+	command := &GenerateCommand{logger: logger.NewSilentLogger()}
+	stats, err := command.libraryStats(preparedRepo{Repo: repo, Dir: dest}, t.TempDir(), true)
+	if err != nil {
+		t.Fatalf("libraryStats() error = %v", err)
+	}
+	if stats != (RepoStats{TestFunctions: 1, ExportedSymbols: 1}) {
+		t.Fatalf("libraryStats() = %+v, want the Go sources counted", stats)
+	}
+	if hasSparseSources(dest) {
+		t.Fatal("libraryStats() left the checkout widened to its Go sources")
+	}
+	if _, err := os.Stat(filepath.Join(dest, "cache.go")); err == nil {
+		t.Fatal("libraryStats() left cache.go checked out")
+	}
+}
+
+// TestSyncRepoKeepsCheckoutWhenUpdateFails verifies a failed update leaves the cached checkout in place instead of recloning.
+func TestSyncRepoKeepsCheckoutWhenUpdateFails(t *testing.T) {
+	t.Parallel()
+
+	source := t.TempDir()
+	runTestGit(t, source, "init", "--quiet", "--initial-branch=main")
+	runTestGit(t, source, "config", "uploadpack.allowFilter", "true")
+	writeTestFiles(t, source, map[string]string{"README.md": "# Cache\n"})
+	runTestGit(t, source, "add", ".")
+	runTestGit(t, source, "commit", "--quiet", "-m", "initial")
+
+	dest := filepath.Join(t.TempDir(), "cache")
+	repo := RepoConfig{Slug: "cache", CloneURL: "file://" + source, Branch: "main"}
+	command := &GenerateCommand{}
+	if _, err := command.syncRepo(repo, dest); err != nil {
+		t.Fatalf("syncRepo() error = %v", err)
+	}
+	if err := os.RemoveAll(source); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := command.syncRepo(repo, dest); err == nil {
+		t.Fatal("syncRepo() error = nil, want the failed update reported")
+	}
+	if _, err := os.Stat(filepath.Join(dest, "README.md")); err != nil {
+		t.Fatalf("syncRepo() removed the existing checkout: %v", err)
+	}
+}
+
+// TestSparsePatternEscapesGitignoreSyntax verifies file names are matched literally and anchored at the repository root.
+func TestSparsePatternEscapesGitignoreSyntax(t *testing.T) {
+	t.Parallel()
+
+	if got := sparsePattern("docs/img/[beta]*.png"); got != `/docs/img/\[beta]\*.png` {
+		t.Fatalf("sparsePattern() = %q, want escaped literal", got)
+	}
+}
+
+// TestLocalImagePathsSkipsRemoteAndEscapingTargets verifies only repository files are requested from the sparse checkout.
+func TestLocalImagePathsSkipsRemoteAndEscapingTargets(t *testing.T) {
+	t.Parallel()
+
+	content := strings.Join([]string{
+		`![a](./img/a.png?raw=true "Title")`,
+		`![b](https://example.com/b.png)`,
+		`![c](../../outside.png)`,
+		`<img src="/assets/c.svg">`,
+		`![d](data:image/png;base64,xyz)`,
+	}, "\n")
+	got := strings.Join(localImagePaths(content, "docs"), ",")
+	if got != "docs/img/a.png,assets/c.svg" {
+		t.Fatalf("localImagePaths() = %q, want docs/img/a.png,assets/c.svg", got)
+	}
+}
@@ -0,0 +1,350 @@
+package docs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	unitBadgeRegex        = regexp.MustCompile(`badge/unit_tests-(\d+)-`)
+	integrationBadgeRegex = regexp.MustCompile(`badge/integration_tests-(\d+)-`)
+)
+
+// RepoStats are source counts taken from the same checkout a generated page was rendered from.
+type RepoStats struct {
+	TestFunctions   int
+	Benchmarks      int
+	ExportedSymbols int
+}
+
+// proofStats mirrors proof-stats.json as bin/collect-proof-stats.mjs writes it, field for field, so either writer
+// leaves the other's output unchanged. Drivers stay raw because their published order is meaningful.
+type proofStats struct {
+	GeneratedAt string           `json:"generatedAt"`
+	Totals      proofStatsTotals `json:"totals"`
+	Drivers     json.RawMessage  `json:"drivers"`
+	Repos       []proofStatsRepo `json:"repos"`
+}
+
+type proofStatsTotals struct {
+	UnitTests        int `json:"unitTests"`
+	IntegrationTests int `json:"integrationTests"`
+	TestFunctions    int `json:"testFunctions"`
+	Benchmarks       int `json:"benchmarks"`
+	Drivers          int `json:"drivers"`
+	Libraries        int `json:"libraries"`
+}
+
+// proofStatsRepo is one library's evidence; unit and integration are the README badge counts, null without a badge.
+type proofStatsRepo struct {
+	Repo           string `json:"repo"`
+	Unit           *int   `json:"unit"`
+	Integration    *int   `json:"integration"`
+	TestFns        int    `json:"testFns"`
+	Benchmarks     int    `json:"benchmarks"`
+	SourceRevision string `json:"sourceRevision"`
+}
+
+// countRepoStats counts the Test and Benchmark functions go test would run in every *_test.go file, and counts exported
+// API the way the API reference sees it, honoring build constraints.
+func countRepoStats(dir string) (RepoStats, error) {
+	var stats RepoStats
+	fset := token.NewFileSet()
+	err := filepath.WalkDir(dir, func(current string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := entry.Name()
+		if entry.IsDir() {
+			if current != dir && (name == "vendor" || name == "testdata" || name == "node_modules" || strings.HasPrefix(name, ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(name, "_test.go") {
+			return nil
+		}
+		file, err := parser.ParseFile(fset, current, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil
+		}
+		tests, benchmarks := testDeclCount(file)
+		stats.TestFunctions += tests
+		stats.Benchmarks += benchmarks
+		return nil
+	})
+	if err != nil {
+		return stats, err
+	}
+
+	exported, err := countExportedSymbols(dir, fset)
+	if err != nil {
+		return stats, err
+	}
+	stats.ExportedSymbols = exported
+	return stats, nil
+}
+
+// countExportedSymbols counts package-level exported names plus exported methods on exported types in importable packages.
+func countExportedSymbols(dir string, fset *token.FileSet) (int, error) {
+	count := 0
+	err := filepath.WalkDir(dir, func(current string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() {
+			return nil
+		}
+		name := entry.Name()
+		if current != dir {
+			if _, skip := apiSkippedDirs[name]; skip || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+				return filepath.SkipDir
+			}
+		}
+		buildPkg, err := build.Default.ImportDir(current, 0)
+		if err != nil || buildPkg.Name == "main" {
+			return nil
+		}
+		for _, goFile := range buildPkg.GoFiles {
+			file, err := parser.ParseFile(fset, filepath.Join(current, goFile), nil, parser.SkipObjectResolution)
+			if err != nil {
+				continue
+			}
+			count += exportedDeclCount(file)
+		}
+		return nil
+	})
+	return count, err
+}
+
+// testDeclCount counts top-level functions named like go test entry points. TestMain runs the others, so it is not a test.
+func testDeclCount(file *ast.File) (int, int) {
+	tests, benchmarks := 0, 0
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || fn.Type.TypeParams != nil {
+			continue
+		}
+		switch name := fn.Name.Name; {
+		case name == "TestMain":
+		case isTestName(name, "Test"):
+			tests++
+		case isTestName(name, "Benchmark"):
+			benchmarks++
+		}
+	}
+	return tests, benchmarks
+}
+
+// isTestName mirrors go test: the prefix alone or followed by anything but a lowercase letter, so Testify is a helper.
+func isTestName(name string, prefix string) bool {
+	rest, ok := strings.CutPrefix(name, prefix)
+	if !ok {
+		return false
+	}
+	if rest == "" {
+		return true
+	}
+	next, _ := utf8.DecodeRuneInString(rest)
+	return !unicode.IsLower(next)
+}
+
+func exportedDeclCount(file *ast.File) int {
+	count := 0
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if !decl.Name.IsExported() {
+				continue
+			}
+			if decl.Recv != nil && !ast.IsExported(receiverTypeName(decl.Recv)) {
+				continue
+			}
+			count++
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					if spec.Name.IsExported() {
+						count++
+					}
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						if name.IsExported() {
+							count++
+						}
+					}
+				}
+			}
+		}
+	}
+	return count
+}
+
+// receiverTypeName unwraps pointer and generic receivers down to the declared type name.
+func receiverTypeName(recv *ast.FieldList) string {
+	if recv == nil || len(recv.List) == 0 {
+		return ""
+	}
+	expr := recv.List[0].Type
+	for {
+		switch typed := expr.(type) {
+		case *ast.StarExpr:
+			expr = typed.X
+		case *ast.IndexExpr:
+			expr = typed.X
+		case *ast.IndexListExpr:
+			expr = typed.X
+		case *ast.Ident:
+			return typed.Name
+		default:
+			return ""
+		}
+	}
+}
+
+// recordedRepoStats reads the counts a generated page carries when that page was rendered from commit.
+func recordedRepoStats(pagePath string, commit string) (RepoStats, bool) {
+	content, err := os.ReadFile(pagePath)
+	if commit == "" || err != nil {
+		return RepoStats{}, false
+	}
+	page, err := parsePageFrontmatter(pagePath, string(content))
+	if err != nil || page.scalar("sourceCommit") != commit {
+		return RepoStats{}, false
+	}
+	var stats RepoStats
+	for key, target := range map[string]*int{
+		"testFunctions":   &stats.TestFunctions,
+		"benchmarks":      &stats.Benchmarks,
+		"exportedSymbols": &stats.ExportedSymbols,
+	} {
+		value, err := strconv.Atoi(page.scalar(key))
+		if err != nil {
+			return RepoStats{}, false
+		}
+		*target = value
+	}
+	return stats, true
+}
+
+// statsFrontmatter leaves counts unquoted so page components can compare them as numbers.
+func statsFrontmatter(stats *RepoStats) string {
+	if stats == nil {
+		return ""
+	}
+	return fmt.Sprintf("testFunctions: %d\nbenchmarks: %d\nexportedSymbols: %d\n", stats.TestFunctions, stats.Benchmarks, stats.ExportedSymbols)
+}
+
+// readmeBadgeCount reads an executed-test badge such as badge/unit_tests-391- from a README; nil means no badge.
+func readmeBadgeCount(readme string, badge *regexp.Regexp) *int {
+	match := badge.FindStringSubmatch(readme)
+	if match == nil {
+		return nil
+	}
+	count, err := strconv.Atoi(match[1])
+	if err != nil {
+		return nil
+	}
+	return &count
+}
+
+// proofStatsEntry builds a library's proof evidence from the checkout its page was generated from.
+func proofStatsEntry(repo RepoConfig, dir string, meta RepoMetadata) proofStatsRepo {
+	readmeRelativePath := repo.ReadmePath
+	if readmeRelativePath == "" {
+		readmeRelativePath = "README.md"
+	}
+	readme, _ := os.ReadFile(filepath.Join(dir, filepath.FromSlash(readmeRelativePath)))
+	return proofStatsRepo{
+		Repo:           repo.Slug,
+		Unit:           readmeBadgeCount(string(readme), unitBadgeRegex),
+		Integration:    readmeBadgeCount(string(readme), integrationBadgeRegex),
+		TestFns:        meta.Stats.TestFunctions,
+		Benchmarks:     meta.Stats.Benchmarks,
+		SourceRevision: meta.CommitSHA,
+	}
+}
+
+// updateProofStats replaces the entries for libraries generated in this run and recomputes the totals they feed.
+// Only libraries proof-stats.json already tracks are updated, so its library list stays owned by the collector; a docs
+// root without the file returns an error wrapping os.ErrNotExist.
+// The collection date moves only when evidence changes. It returns the rendered file and the updated slugs.
+func updateProofStats(statsPath string, entries []proofStatsRepo, today string) (string, []string, error) {
+	content, err := os.ReadFile(statsPath)
+	if err != nil {
+		return "", nil, fmt.Errorf("read %s: %w", proofStatsPath, err)
+	}
+	var stats proofStats
+	if err := json.Unmarshal(content, &stats); err != nil {
+		return "", nil, fmt.Errorf("parse %s: %w", proofStatsPath, err)
+	}
+	before, err := renderProofStats(stats)
+	if err != nil {
+		return "", nil, err
+	}
+
+	index := map[string]int{}
+	for i, repo := range stats.Repos {
+		index[repo.Repo] = i
+	}
+	var updated []string
+	for _, entry := range entries {
+		i, tracked := index[entry.Repo]
+		if !tracked || entry.SourceRevision == "" {
+			continue
+		}
+		stats.Repos[i] = entry
+		updated = append(updated, entry.Repo)
+	}
+
+	totals := proofStatsTotals{Drivers: stats.Totals.Drivers, Libraries: len(stats.Repos)}
+	for _, repo := range stats.Repos {
+		if repo.Unit != nil {
+			totals.UnitTests += *repo.Unit
+		}
+		if repo.Integration != nil {
+			totals.IntegrationTests += *repo.Integration
+		}
+		totals.TestFunctions += repo.TestFns
+		totals.Benchmarks += repo.Benchmarks
+	}
+	stats.Totals = totals
+
+	rendered, err := renderProofStats(stats)
+	if err != nil {
+		return "", nil, err
+	}
+	if rendered != before {
+		stats.GeneratedAt = today
+		if rendered, err = renderProofStats(stats); err != nil {
+			return "", nil, err
+		}
+	}
+	return rendered, updated, nil
+}
+
+// renderProofStats matches JSON.stringify(stats, null, 2) so regenerating unchanged evidence rewrites nothing.
+func renderProofStats(stats proofStats) (string, error) {
+	var out bytes.Buffer
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(stats); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
@@ -0,0 +1,172 @@
+package docs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestCountRepoStatsMatchesProofMethodology verifies test counts include nested modules but only declarations go test
+// would run, while exported API skips examples and hidden types.
+func TestCountRepoStatsMatchesProofMethodology(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFiles(t, dir, map[string]string{
+		"cache.go": `package cache
+
+const DefaultTTL = 5
+
+var ErrMiss, errHidden = error(nil), error(nil)
+
+type Store struct{}
+
+type memory struct{}
+
+func New() *Store { return &Store{} }
+
+func (s *Store) Get(key string) string { return key }
+
+func (m *memory) Get(key string) string { return key }
+
+func helper() {}
+`,
+		"cache_test.go":                    "package cache\n\nimport \"testing\"\n\nfunc TestGet(t *testing.T) {}\nfunc TestSet(t *testing.T) {}\nfunc Test(t *testing.T) {}\nfunc BenchmarkGet(b *testing.B) {}\nfunc helperTest() {}\n",
+		"main_test.go":                     "package cache\n\nimport \"testing\"\n\ntype suite struct{}\n\nfunc TestMain(m *testing.M) {}\nfunc Testify(t *testing.T) {}\nfunc Benchmarks() {}\nfunc (suite) TestMethod(t *testing.T) {}\n\n// func TestInComment(t *testing.T) {}\nconst fixture = `\nfunc TestInString(t *testing.T) {}\nfunc BenchmarkInString(b *testing.B) {}\n`\n",
+		"driver/rediscache/redis.go":       "package rediscache\n\ntype Driver struct{}\n",
+		"driver/rediscache/redis_test.go":  "package rediscache\n\nimport \"testing\"\n\nfunc TestRedis(t *testing.T) {}\n",
+		"examples/basic/main.go":           "package main\n\nfunc Exported() {}\n\nfunc main() {}\n",
+		"internal/ignored_linux_only.go":   "//go:build ignore\n\npackage internal\n\nfunc Ignored() {}\n",
+		".github/scripts/hidden_test.go":   "package scripts\n\nimport \"testing\"\n\nfunc TestHidden(t *testing.T) {}\n",
+		"testdata/broken/fixture_test.go":  "package broken\n\nfunc TestBroken(",
+		"vendor/example.com/dep/dep.go":    "package dep\n\nfunc Vendored() {}\n",
+		"vendor/example.com/dep/x_test.go": "package dep\n\nimport \"testing\"\n\nfunc TestVendored(t *testing.T) {}\n",
+	})
+
+	got, err := countRepoStats(dir)
+	if err != nil {
+		t.Fatalf("countRepoStats() error = %v", err)
+	}
+	want := RepoStats{TestFunctions: 4, Benchmarks: 1, ExportedSymbols: 6}
+	if got != want {
+		t.Fatalf("countRepoStats() = %+v, want %+v", got, want)
+	}
+}
+
+// TestUpdateProofStatsReplacesTrackedLibraries verifies generated libraries replace their proof entries, totals follow,
+// untracked libraries are ignored and the file keeps the collector's exact formatting.
+func TestUpdateProofStatsReplacesTrackedLibraries(t *testing.T) {
+	t.Parallel()
+
+	existing := `{
+  "generatedAt": "2026-08-01",
+  "totals": {
+    "unitTests": 10,
+    "integrationTests": 2,
+    "testFunctions": 12,
+    "benchmarks": 1,
+    "drivers": 2,
+    "libraries": 2
+  },
+  "drivers": {
+    "queue": [
+      "sync",
+      "redis"
+    ],
+    "cache": []
+  },
+  "repos": [
+    {
+      "repo": "cache",
+      "unit": 10,
+      "integration": 2,
+      "testFns": 7,
+      "benchmarks": 1,
+      "sourceRevision": "old"
+    },
+    {
+      "repo": "str",
+      "unit": null,
+      "integration": null,
+      "testFns": 5,
+      "benchmarks": 0,
+      "sourceRevision": "kept"
+    }
+  ]
+}
+`
+	statsPath := filepath.Join(t.TempDir(), "proof-stats.json")
+	if err := os.WriteFile(statsPath, []byte(existing), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	unchanged, updated, err := updateProofStats(statsPath, nil, "2026-09-01")
+	if err != nil {
+		t.Fatalf("updateProofStats() error = %v", err)
+	}
+	if unchanged != existing || len(updated) != 0 {
+		t.Fatalf("updateProofStats() without entries =\n%s\nwant the file unchanged", unchanged)
+	}
+
+	dir := t.TempDir()
+	writeTestFiles(t, dir, map[string]string{
+		"README.md":     "![Unit](https://img.shields.io/badge/unit_tests-12-green)\n",
+		"cache_test.go": "package cache\n\nfunc TestGet(t *testing.T) {}\n",
+	})
+	stats, err := countRepoStats(dir)
+	if err != nil {
+		t.Fatalf("countRepoStats() error = %v", err)
+	}
+	entries := []proofStatsRepo{
+		proofStatsEntry(RepoConfig{Slug: "cache"}, dir, RepoMetadata{CommitSHA: "new", Stats: &stats}),
+		proofStatsEntry(RepoConfig{Slug: "retired"}, dir, RepoMetadata{CommitSHA: "new", Stats: &stats}),
+	}
+	rendered, updated, err := updateProofStats(statsPath, entries, "2026-09-01")
+	if err != nil {
+		t.Fatalf("updateProofStats() error = %v", err)
+	}
+	if len(updated) != 1 || updated[0] != "cache" {
+		t.Fatalf("updateProofStats() updated = %v, want only the tracked cache entry", updated)
+	}
+	for _, want := range []string{
+		`"generatedAt": "2026-09-01"`,
+		"\"unitTests\": 12,\n    \"integrationTests\": 0,\n    \"testFunctions\": 6,\n    \"benchmarks\": 0,\n    \"drivers\": 2,\n    \"libraries\": 2",
+		"\"queue\": [\n      \"sync\",\n      \"redis\"\n    ],\n    \"cache\": []",
+		"\"repo\": \"cache\",\n      \"unit\": 12,\n      \"integration\": null,\n      \"testFns\": 1,\n      \"benchmarks\": 0,\n      \"sourceRevision\": \"new\"",
+		`"sourceRevision": "kept"`,
+	} {
+		if !strings.Contains(rendered, want) {
+			t.Fatalf("updateProofStats() =\n%s\nwant %q", rendered, want)
+		}
+	}
+}
+
+// TestRecordedRepoStatsRequiresSameCommit verifies counts are reused only from a page generated from the same commit.
+func TestRecordedRepoStatsRequiresSameCommit(t *testing.T) {
+	t.Parallel()
+
+	pagePath := filepath.Join(t.TempDir(), "cache.md")
+	page := "---\ntitle: \"Cache\"\nsourceCommit: \"abc123\"\ntestFunctions: 12\nbenchmarks: 3\nexportedSymbols: 40\n---\n\n# Cache\n"
+	if err := os.WriteFile(pagePath, []byte(page), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := recordedRepoStats(pagePath, "abc123"); !ok || got != (RepoStats{TestFunctions: 12, Benchmarks: 3, ExportedSymbols: 40}) {
+		t.Fatalf("recordedRepoStats() = %+v, %t; want the page counts", got, ok)
+	}
+	if _, ok := recordedRepoStats(pagePath, "def456"); ok {
+		t.Fatal("recordedRepoStats() reused counts from another commit")
+	}
+}
+
+// TestTransformReadmeEmbedsStats verifies counts land in the library page frontmatter as numbers.
+func TestTransformReadmeEmbedsStats(t *testing.T) {
+	t.Parallel()
+
+	repo := RepoConfig{Slug: "cache", Title: "Cache", CloneURL: "https://github.com/goforj/cache.git", Branch: "main"}
+	meta := RepoMetadata{Stats: &RepoStats{TestFunctions: 12, Benchmarks: 3, ExportedSymbols: 40}}
+	got := transformReadme("# Cache\n", repo, "https://raw.githubusercontent.com/goforj/cache/main/", meta, libraryDependencies{})
+	if !strings.Contains(got, "testFunctions: 12\nbenchmarks: 3\nexportedSymbols: 40\n") {
+		t.Fatalf("transformReadme() frontmatter missing stats:\n%s", got)
+	}
+}
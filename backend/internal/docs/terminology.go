@@ -0,0 +1,288 @@
+package docs
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const terminologyRulesPath = "ai/terminology-rules.json"
+
+const (
+	severityError   = "error"
+	severityWarning = "warning"
+)
+
+var termsSuppressionRegex = regexp.MustCompile(`<!--\s*docs-terms-(disable-next-line|disable|enable)((?:\s+[a-z0-9-]+)*)\s*-->`)
+var termsMaskRegex = regexp.MustCompile("(`+)[^`]*?(`+)|<!--.*?-->|<[^>\n]+>|\\]\\([^)]*\\)|https?://[^\\s)>\\]]+")
+
+// termRule is one entry of ai/terminology-rules.json. Fix marks replacements that never need rewording.
+type termRule struct {
+	ID         string   `json:"id"`
+	Preferred  string   `json:"preferred"`
+	Forbidden  []string `json:"forbidden"`
+	IgnoreCase bool     `json:"ignoreCase"`
+	Scope      []string `json:"scope"`
+	Exclude    []string `json:"exclude"`
+	Severity   string   `json:"severity"`
+	Fix        bool     `json:"fix"`
+	Source     string   `json:"source"`
+	patterns   []*regexp.Regexp
+	scope      []*regexp.Regexp
+	exclude    []*regexp.Regexp
+}
+
+// termFinding is a forbidden variant found on a line, with a 1-based byte column.
+type termFinding struct {
+	File    string
+	Line    int
+	Column  int
+	Match   string
+	Rule    *termRule
+	Applied bool
+}
+
+func (finding termFinding) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s: use %q instead of %q [%s]", finding.File, finding.Line, finding.Column, finding.Rule.Severity, finding.Rule.Preferred, finding.Match, finding.Rule.ID)
+}
+
+// loadTermRules reads and compiles the rule file, rejecting rules that could never match or report.
+func loadTermRules(file string) ([]*termRule, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", terminologyRulesPath, err)
+	}
+	var document struct {
+		Rules []*termRule `json:"rules"`
+	}
+	if err := json.Unmarshal(content, &document); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", terminologyRulesPath, err)
+	}
+	seen := map[string]struct{}{}
+	for _, rule := range document.Rules {
+		if err := rule.compile(); err != nil {
+			return nil, fmt.Errorf("%s: rule %q: %w", terminologyRulesPath, rule.ID, err)
+		}
+		if _, ok := seen[rule.ID]; ok {
+			return nil, fmt.Errorf("%s: duplicate rule %q", terminologyRulesPath, rule.ID)
+		}
+		seen[rule.ID] = struct{}{}
+	}
+	return document.Rules, nil
+}
+
+func (rule *termRule) compile() error {
+	switch {
+	case rule.ID == "":
+		return fmt.Errorf("missing id")
+	case rule.Preferred == "" || len(rule.Forbidden) == 0:
+		return fmt.Errorf("needs a preferred term and at least one forbidden variant")
+	case rule.Severity != severityError && rule.Severity != severityWarning:
+		return fmt.Errorf("severity must be %s or %s", severityError, severityWarning)
+	case len(rule.Scope) == 0:
+		return fmt.Errorf("needs at least one scope glob")
+	}
+	for _, variant := range rule.Forbidden {
+		expr := regexp.QuoteMeta(variant)
+		if isWordByte(variant[0]) {
+			expr = `\b` + expr
+		}
+		if isWordByte(variant[len(variant)-1]) {
+			expr += `\b`
+		}
+		if rule.IgnoreCase {
+			expr = "(?i)" + expr
+		}
+		rule.patterns = append(rule.patterns, regexp.MustCompile(expr))
+	}
+	rule.scope = compileGlobs(rule.Scope)
+	rule.exclude = compileGlobs(rule.Exclude)
+	return nil
+}
+
+func (rule *termRule) applies(file string) bool {
+	return matchesAnyGlob(rule.scope, file) && !matchesAnyGlob(rule.exclude, file)
+}
+
+func isWordByte(b byte) bool {
+	return b == '_' || b >= '0' && b <= '9' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z'
+}
+
+// compileGlobs turns slash globs into anchored patterns: ** spans directories, * and ? stay within one segment.
+func compileGlobs(globs []string) []*regexp.Regexp {
+	compiled := make([]*regexp.Regexp, 0, len(globs))
+	for _, glob := range globs {
+		var expr strings.Builder
+		expr.WriteString("^")
+		for i := 0; i < len(glob); i++ {
+			switch {
+			case strings.HasPrefix(glob[i:], "**/"):
+				expr.WriteString("(?:.*/)?")
+				i += 2
+			case strings.HasPrefix(glob[i:], "**"):
+				expr.WriteString(".*")
+				i++
+			case glob[i] == '*':
+				expr.WriteString("[^/]*")
+			case glob[i] == '?':
+				expr.WriteString("[^/]")
+			default:
+				expr.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+			}
+		}
+		expr.WriteString("$")
+		compiled = append(compiled, regexp.MustCompile(expr.String()))
+	}
+	return compiled
+}
+
+func matchesAnyGlob(globs []*regexp.Regexp, file string) bool {
+	for _, glob := range globs {
+		if glob.MatchString(file) {
+			return true
+		}
+	}
+	return false
+}
+
+// termSuppressions tracks disable/enable blocks and disable-next-line comments; an empty rule list means every rule.
+type termSuppressions struct {
+	block    map[string]bool
+	all      bool
+	nextLine map[string]bool
+	nextAll  bool
+}
+
+// takeNextLine hands the pending disable-next-line scope to the current line and clears it.
+func (s *termSuppressions) takeNextLine() func(rule string) bool {
+	all, rules := s.nextAll, s.nextLine
+	s.nextAll, s.nextLine = false, nil
+	return func(rule string) bool {
+		return s.all || s.block[rule] || all || rules[rule]
+	}
+}
+
+func (s *termSuppressions) read(line string) {
+	for _, match := range termsSuppressionRegex.FindAllStringSubmatch(line, -1) {
+		ids := strings.Fields(match[2])
+		switch match[1] {
+		case "disable-next-line":
+			s.nextAll = len(ids) == 0
+			s.nextLine = map[string]bool{}
+			for _, id := range ids {
+				s.nextLine[id] = true
+			}
+		case "disable":
+			s.all = s.all || len(ids) == 0
+			for _, id := range ids {
+				s.block[id] = true
+			}
+		case "enable":
+			if len(ids) == 0 {
+				s.all = false
+				s.block = map[string]bool{}
+			}
+			for _, id := range ids {
+				delete(s.block, id)
+			}
+		}
+	}
+}
+
+// lintTerms checks one file outside frontmatter and code fences, masking inline code, HTML, link targets and URLs so only prose is matched.
+// With fix set, fixable findings are replaced in the returned content and marked Applied.
+func lintTerms(file string, content string, rules []*termRule, fix bool) ([]termFinding, string) {
+	var active []*termRule
+	for _, rule := range rules {
+		if rule.applies(file) {
+			active = append(active, rule)
+		}
+	}
+	if len(active) == 0 {
+		return nil, content
+	}
+
+	// Frontmatter titles and descriptions are page data owned by their generators, so they are neither linted nor rewritten.
+	bodyStart := 0
+	if loc := frontmatterBlockRegex.FindStringIndex(content); loc != nil {
+		bodyStart = strings.Count(content[:loc[1]], "\n")
+	}
+	lines := strings.Split(content, "\n")
+	suppressions := termSuppressions{block: map[string]bool{}}
+	var findings []termFinding
+	forEachProseLine(lines, func(index int, line string) {
+		if index < bodyStart {
+			return
+		}
+		suppressed := suppressions.takeNextLine()
+		suppressions.read(inlineCodeRegex.ReplaceAllString(line, ""))
+		masked := termsMaskRegex.ReplaceAllStringFunc(line, func(span string) string {
+			return strings.Repeat(" ", len(span))
+		})
+		type replacement struct {
+			start, end, finding int
+			text                string
+		}
+		var replacements []replacement
+		for _, rule := range active {
+			if suppressed(rule.ID) {
+				continue
+			}
+			for _, pattern := range rule.patterns {
+				for _, loc := range pattern.FindAllStringIndex(masked, -1) {
+					if fix && rule.Fix {
+						replacements = append(replacements, replacement{loc[0], loc[1], len(findings), rule.Preferred})
+					}
+					findings = append(findings, termFinding{File: file, Line: index + 1, Column: loc[0] + 1, Match: line[loc[0]:loc[1]], Rule: rule})
+				}
+			}
+		}
+		sort.Slice(replacements, func(i, j int) bool { return replacements[i].start > replacements[j].start })
+		end := len(line) + 1
+		for _, r := range replacements {
+			if r.end > end {
+				continue
+			}
+			line = line[:r.start] + r.text + line[r.end:]
+			findings[r.finding].Applied = true
+			end = r.start
+		}
+		lines[index] = line
+	})
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Line != findings[j].Line {
+			return findings[i].Line < findings[j].Line
+		}
+		return findings[i].Column < findings[j].Column
+	})
+	return findings, strings.Join(lines, "\n")
+}
+
+// termFiles lists the Markdown files rules may scope to, relative to repoRoot: the docs site and the ai/ guides.
+func termFiles(repoRoot string, docsRoot string) ([]string, error) {
+	site, err := loadDocsSite(docsRoot, nil)
+	if err != nil {
+		return nil, err
+	}
+	docsRel, err := filepath.Rel(repoRoot, docsRoot)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]string, 0, len(site.Files))
+	for _, file := range site.Files {
+		files = append(files, filepath.ToSlash(filepath.Join(docsRel, file)))
+	}
+	guides, err := filepath.Glob(filepath.Join(repoRoot, "ai", "*.md"))
+	if err != nil {
+		return nil, err
+	}
+	for _, guide := range guides {
+		files = append(files, "ai/"+filepath.Base(guide))
+	}
+	sort.Strings(files)
+	return files, nil
+}
@@ -0,0 +1,109 @@
+package docs
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestLintTermsSkipsCodeAndHonorsSuppressions verifies only prose is matched and suppression comments are scoped correctly.
+func TestLintTermsSkipsCodeAndHonorsSuppressions(t *testing.T) {
+	t.Parallel()
+
+	rules := []*termRule{
+		{ID: "brand-name", Preferred: "GoForj", Forbidden: []string{"Goforj"}, Scope: []string{"docs/**/*.md"}, Exclude: []string{"docs/libraries/**"}, Severity: severityError, Fix: true},
+		{ID: "generated-app", Preferred: "app", Forbidden: []string{"generated App"}, Scope: []string{"docs/**/*.md"}, Severity: severityWarning},
+	}
+	for _, rule := range rules {
+		if err := rule.compile(); err != nil {
+			t.Fatalf("compile() error = %v", err)
+		}
+	}
+	content := strings.Join([]string{
+		"Goforj builds a generated App.",
+		"```sh",
+		"Goforj in a fence",
+		"```",
+		"Run `Goforj` or visit https://example.com/Goforj and [docs](/Goforj).",
+		"<!-- docs-terms-disable-next-line generated-app -->",
+		"A generated App by Goforj.",
+		"<!-- docs-terms-disable -->",
+		"Goforj ignored.",
+		"<!-- docs-terms-enable -->",
+		"Say `<!-- docs-terms-disable -->` then Goforjs and Goforj.",
+	}, "\n")
+
+	findings, fixed := lintTerms("docs/guide/index.md", content, rules, true)
+	var got []string
+	for _, finding := range findings {
+		got = append(got, finding.String())
+		if finding.Rule.ID == "brand-name" && !finding.Applied {
+			t.Fatalf("lintTerms() did not fix %v", finding)
+		}
+	}
+	want := []string{
+		`docs/guide/index.md:1:1: error: use "GoForj" instead of "Goforj" [brand-name]`,
+		`docs/guide/index.md:1:17: warning: use "app" instead of "generated App" [generated-app]`,
+		`docs/guide/index.md:7:20: error: use "GoForj" instead of "Goforj" [brand-name]`,
+		`docs/guide/index.md:11:52: error: use "GoForj" instead of "Goforj" [brand-name]`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("lintTerms() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if !strings.HasPrefix(fixed, "GoForj builds a generated App.\n```sh\nGoforj in a fence\n") || !strings.HasSuffix(fixed, "then Goforjs and GoForj.") {
+		t.Fatalf("lintTerms() fixed content =\n%s", fixed)
+	}
+
+	if findings, _ := lintTerms("docs/libraries/cache.md", content, rules[:1], false); len(findings) != 0 {
+		t.Fatalf("lintTerms() = %v, want excluded path to be skipped", findings)
+	}
+}
+
+// TestLintTermsSkipsFrontmatter verifies titles and descriptions in the leading frontmatter block are never linted or fixed.
+func TestLintTermsSkipsFrontmatter(t *testing.T) {
+	t.Parallel()
+
+	rule := &termRule{ID: "brand-name", Preferred: "GoForj", Forbidden: []string{"Goforj"}, Scope: []string{"docs/**/*.md"}, Severity: severityError, Fix: true}
+	if err := rule.compile(); err != nil {
+		t.Fatalf("compile() error = %v", err)
+	}
+	content := "---\ntitle: \"Goforj App\"\ndescription: Goforj apps\n---\n\nGoforj builds apps.\n\n---\n\nGoforj again.\n"
+
+	findings, fixed := lintTerms("docs/guide/index.md", content, []*termRule{rule}, true)
+	var got []string
+	for _, finding := range findings {
+		got = append(got, finding.String())
+	}
+	want := []string{
+		`docs/guide/index.md:6:1: error: use "GoForj" instead of "Goforj" [brand-name]`,
+		`docs/guide/index.md:10:1: error: use "GoForj" instead of "Goforj" [brand-name]`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("lintTerms() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if !strings.HasPrefix(fixed, "---\ntitle: \"Goforj App\"\ndescription: Goforj apps\n---\n") {
+		t.Fatalf("lintTerms() rewrote frontmatter:\n%s", fixed)
+	}
+}
+
+// TestLoadTermRulesRejectsIncompleteRules verifies rule files fail fast instead of silently matching nothing.
+func TestLoadTermRulesRejectsIncompleteRules(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFiles(t, dir, map[string]string{
+		"ok.json":       `{"rules": [{"id": "a", "preferred": "app", "forbidden": ["App"], "scope": ["docs/*.md"], "severity": "warning"}]}`,
+		"severity.json": `{"rules": [{"id": "a", "preferred": "app", "forbidden": ["App"], "scope": ["docs/*.md"], "severity": "fatal"}]}`,
+		"scope.json":    `{"rules": [{"id": "a", "preferred": "app", "forbidden": ["App"], "severity": "error"}]}`,
+	})
+
+	rules, err := loadTermRules(filepath.Join(dir, "ok.json"))
+	if err != nil || len(rules) != 1 || !rules[0].applies("docs/index.md") || rules[0].applies("docs/guide/index.md") {
+		t.Fatalf("loadTermRules() = %v, %v; want one rule scoped to top-level docs", rules, err)
+	}
+	for _, file := range []string{"severity.json", "scope.json"} {
+		if _, err := loadTermRules(filepath.Join(dir, file)); err == nil {
+			t.Fatalf("loadTermRules(%s) error = nil, want validation error", file)
+		}
+	}
+}
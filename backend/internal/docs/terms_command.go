@@ -0,0 +1,81 @@
+package docs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/goforj/docs/internal/logger"
+)
+
+// TermsCommand lints Markdown prose against the terminology rules derived from the ai/ style guides.
+type TermsCommand struct {
+	Output string `name:"output" type:"path" env:"DOCS_OUTPUT_DIR" help:"Docs root holding the public pages (defaults to ./docs or ../docs)"`
+	Rules  string `name:"rules" type:"path" help:"Terminology rule file (defaults to ai/terminology-rules.json beside the docs root)"`
+	Fix    bool   `name:"fix" help:"Replace forbidden variants with the preferred term for rules marked safe to fix"`
+	logger *logger.AppLogger
+}
+
+// NewDocsTermsCommand creates a new TermsCommand.
+func NewDocsTermsCommand(logger *logger.AppLogger) *TermsCommand {
+	return &TermsCommand{
+		logger: logger,
+	}
+}
+
+// Run prints each finding as path:line:column and fails only on error-severity findings left after fixing.
+func (c *TermsCommand) Run() error {
+	docsRoot, err := resolveDocsRoot(c.Output)
+	if err != nil {
+		return err
+	}
+	repoRoot := filepath.Dir(docsRoot)
+	rulesPath := c.Rules
+	if rulesPath == "" {
+		rulesPath = filepath.Join(repoRoot, filepath.FromSlash(terminologyRulesPath))
+	}
+	rules, err := loadTermRules(rulesPath)
+	if err != nil {
+		return err
+	}
+	files, err := termFiles(repoRoot, docsRoot)
+	if err != nil {
+		return err
+	}
+	c.logger.Info().Any("rules", len(rules)).Any("files", len(files)).Msg("Loaded terminology rules")
+
+	errorCount, warningCount, fixed := 0, 0, 0
+	for _, file := range files {
+		absolute := filepath.Join(repoRoot, filepath.FromSlash(file))
+		content, err := os.ReadFile(absolute)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", file, err)
+		}
+		findings, updated := lintTerms(file, string(content), rules, c.Fix)
+		for _, finding := range findings {
+			if finding.Applied {
+				fixed++
+				continue
+			}
+			fmt.Println(finding)
+			if finding.Rule.Severity == severityError {
+				errorCount++
+			} else {
+				warningCount++
+			}
+		}
+		if c.Fix {
+			if err := writeGeneratedPage(absolute, updated); err != nil {
+				return fmt.Errorf("write %s: %w", file, err)
+			}
+		}
+	}
+	if fixed > 0 {
+		c.logger.Info().Any("replacements", fixed).Msg("Applied terminology fixes")
+	}
+	if errorCount > 0 {
+		return fmt.Errorf("terminology rules failed with %d error(s) and %d warning(s)", errorCount, warningCount)
+	}
+	c.logger.Info().Any("warnings", warningCount).Msg("Docs follow terminology rules")
+	return nil
+}
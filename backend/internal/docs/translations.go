@@ -0,0 +1,112 @@
+package docs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// libraryTranslation is a localized README found beside the English one.
+type libraryTranslation struct {
+	Lang       string
+	ReadmePath string
+	Readme     []byte
+}
+
+// localizedReadmePath follows the README.<lang>.md convention next to the configured README.
+func localizedReadmePath(readmePath string, lang string) string {
+	ext := path.Ext(readmePath)
+	return strings.TrimSuffix(readmePath, ext) + "." + lang + ext
+}
+
+// loadLibraryTranslations reads the localized READMEs for the configured languages, expanding includes the same way as the English page.
+func loadLibraryTranslations(dir string, readmePath string, languages []string, expand func(content string, sourceDir string) (string, error)) ([]libraryTranslation, error) {
+	var translations []libraryTranslation
+	for _, lang := range languages {
+		localized := localizedReadmePath(readmePath, lang)
+		content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(localized)))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", localized, err)
+		}
+		expanded, err := expand(string(content), sourceDirOf(localized))
+		if err != nil {
+			return nil, fmt.Errorf("expand %s includes: %w", localized, err)
+		}
+		translations = append(translations, libraryTranslation{Lang: lang, ReadmePath: localized, Readme: []byte(expanded)})
+	}
+	return translations, nil
+}
+
+// translationOutputPath nests the translated page under its language: libraries/cache.md becomes zh-CN/libraries/cache.md.
+func translationOutputPath(repo RepoConfig, lang string) string {
+	return filepath.Join(lang, repo.OutputPath)
+}
+
+// translationRoute is served without rewrites, unlike the English page.
+func translationRoute(repo RepoConfig, lang string) string {
+	return "/" + lang + "/" + strings.TrimSuffix(filepath.ToSlash(repo.OutputPath), filepath.Ext(repo.OutputPath))
+}
+
+// transformTranslation runs the English page pipeline against the localized README so links, images and anchors match.
+func transformTranslation(translation libraryTranslation, repo RepoConfig, rawBase string, meta RepoMetadata, deps libraryDependencies) string {
+	repo.ReadmePath = translation.ReadmePath
+	meta.Lang = translation.Lang
+	return transformReadme(string(translation.Readme), repo, rawBase, meta, deps)
+}
+
+// translationFrontmatter lists every language version of the page so the theme can emit hreflang alternates.
+func translationFrontmatter(repo RepoConfig, meta RepoMetadata) string {
+	if len(meta.Languages) == 0 {
+		return ""
+	}
+	var out strings.Builder
+	if meta.Lang != "" {
+		fmt.Fprintf(&out, "lang: %s\n", strconv.Quote(meta.Lang))
+	}
+	out.WriteString("hreflang:\n")
+	fmt.Fprintf(&out, "  - lang: %s\n    link: %s\n", strconv.Quote("en"), strconv.Quote(libraryRoute(repo)))
+	for _, lang := range meta.Languages {
+		fmt.Fprintf(&out, "  - lang: %s\n    link: %s\n", strconv.Quote(lang), strconv.Quote(translationRoute(repo, lang)))
+	}
+	return out.String()
+}
+
+// outdatedTranslations reports translations whose last commit predates the English README's, using commit dates from git.
+// Callers must provide enough history for path-limited logs; a shallow clone dates every file to its single commit.
+func outdatedTranslations(dir string, readmePath string, translations []libraryTranslation) ([]string, error) {
+	english, err := lastCommitTime(dir, readmePath)
+	if err != nil || english.IsZero() {
+		return nil, err
+	}
+	var outdated []string
+	for _, translation := range translations {
+		translated, err := lastCommitTime(dir, translation.ReadmePath)
+		if err != nil {
+			return nil, err
+		}
+		if !translated.IsZero() && translated.Before(english) {
+			outdated = append(outdated, translation.Lang)
+		}
+	}
+	return outdated, nil
+}
+
+func lastCommitTime(dir string, file string) (time.Time, error) {
+	output, err := gitOutput(dir, "log", "-1", "--format=%cI", "--", file)
+	if err != nil {
+		return time.Time{}, err
+	}
+	value := strings.TrimSpace(output)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
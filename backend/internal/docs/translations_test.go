@@ -0,0 +1,96 @@
+package docs
+
+import (
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestLoadLibraryTranslationsFollowsLanguageList verifies only configured locales are published, beside the README they translate.
+func TestLoadLibraryTranslationsFollowsLanguageList(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFiles(t, dir, map[string]string{
+		"docs/README.md":       "# Cache\n",
+		"docs/README.zh-CN.md": "# 缓存\n",
+		"docs/README.fr.md":    "# Cache (fr)\n",
+	})
+	identity := func(content string, _ string) (string, error) { return content, nil }
+
+	got, err := loadLibraryTranslations(dir, "docs/README.md", []string{"zh-CN", "es"}, identity)
+	if err != nil {
+		t.Fatalf("loadLibraryTranslations() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Lang != "zh-CN" || got[0].ReadmePath != "docs/README.zh-CN.md" {
+		t.Fatalf("loadLibraryTranslations() = %+v, want only the zh-CN README", got)
+	}
+}
+
+// TestTransformTranslationRewritesLikeEnglishPage verifies translated pages share rewriting, edit links and hreflang alternates.
+func TestTransformTranslationRewritesLikeEnglishPage(t *testing.T) {
+	t.Parallel()
+
+	repo := RepoConfig{
+		Slug:       "cache",
+		Title:      "Cache",
+		CloneURL:   "https://github.com/goforj/cache.git",
+		Branch:     "main",
+		OutputPath: filepath.Join("libraries", "cache.md"),
+		ReadmePath: "README.md",
+	}
+	meta := RepoMetadata{Languages: []string{"zh-CN"}}
+	translation := libraryTranslation{Lang: "zh-CN", ReadmePath: "README.zh-CN.md", Readme: []byte("# 缓存\n\n![图](docs/a.png)\n\n见 [驱动](driver/)。\n")}
+	rawBase := rawSourceBase(repo, "main")
+
+	got := transformTranslation(translation, repo, rawBase, meta, libraryDependencies{})
+	for _, want := range []string{
+		"lang: \"zh-CN\"\nhreflang:\n  - lang: \"en\"\n    link: \"/cache\"\n  - lang: \"zh-CN\"\n    link: \"/zh-CN/libraries/cache\"\n",
+		"editLink: \"https://github.com/goforj/cache/edit/main/README.zh-CN.md\"",
+		"(https://raw.githubusercontent.com/goforj/cache/main/docs/a.png)",
+		"(https://github.com/goforj/cache/tree/main/driver/)",
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("transformTranslation() missing %q in:\n%s", want, got)
+		}
+	}
+	if translationOutputPath(repo, "zh-CN") != filepath.Join("zh-CN", "libraries", "cache.md") {
+		t.Fatalf("translationOutputPath() = %q", translationOutputPath(repo, "zh-CN"))
+	}
+
+	english := transformReadme("# Cache\n", repo, rawBase, meta, libraryDependencies{})
+	if strings.Contains(english, "\nlang:") || !strings.Contains(english, "link: \"/zh-CN/libraries/cache\"") {
+		t.Fatalf("transformReadme() should list alternates without a lang override:\n%s", english)
+	}
+}
+
+// TestOutdatedTranslationsComparesCommitDates verifies a translation last touched before the English README is reported.
+func TestOutdatedTranslationsComparesCommitDates(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	runTestGit(t, dir, "init", "--quiet", "--initial-branch=main")
+	commitAt := func(date string, files map[string]string) {
+		t.Helper()
+		writeTestFiles(t, dir, files)
+		runTestGit(t, dir, "add", ".")
+		cmd := exec.Command("git", "-C", dir, "-c", "user.name=Docs", "-c", "user.email=docs@example.com", "-c", "commit.gpgsign=false", "commit", "--quiet", "-m", "update")
+		cmd.Env = append(cmd.Environ(), "GIT_AUTHOR_DATE="+date, "GIT_COMMITTER_DATE="+date)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git commit: %v\n%s", err, output)
+		}
+	}
+	commitAt("2026-08-01T10:00:00Z", map[string]string{"README.md": "# Cache\n", "README.zh-CN.md": "# 缓存\n", "README.es.md": "# Caché\n"})
+	commitAt("2026-08-05T10:00:00Z", map[string]string{"README.md": "# Cache\n\nNew section.\n"})
+	commitAt("2026-08-06T10:00:00Z", map[string]string{"README.es.md": "# Caché\n\nSección nueva.\n"})
+
+	translations := []libraryTranslation{{Lang: "zh-CN", ReadmePath: "README.zh-CN.md"}, {Lang: "es", ReadmePath: "README.es.md"}}
+	got, err := outdatedTranslations(dir, "README.md", translations)
+	if err != nil {
+		t.Fatalf("outdatedTranslations() error = %v", err)
+	}
+	if strings.Join(got, ",") != "zh-CN" {
+		t.Fatalf("outdatedTranslations() = %q, want zh-CN", got)
+	}
+}
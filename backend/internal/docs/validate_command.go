@@ -0,0 +1,60 @@
+package docs
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/goforj/docs/internal/logger"
+)
+
+// ValidateCommand checks every page's frontmatter against the per-section schema.
+type ValidateCommand struct {
+	Output string `name:"output" type:"path" env:"DOCS_OUTPUT_DIR" help:"Docs root to validate (defaults to ./docs or ../docs)"`
+	Schema string `name:"schema" type:"path" help:"Frontmatter schema (defaults to .vitepress/data/frontmatter-schema.json under the docs root)"`
+	logger *logger.AppLogger
+}
+
+// NewDocsValidateCommand creates a new ValidateCommand.
+func NewDocsValidateCommand(logger *logger.AppLogger) *ValidateCommand {
+	return &ValidateCommand{
+		logger: logger,
+	}
+}
+
+// Run prints each problem as path:line, then a per-section summary, and fails when any page is invalid.
+func (c *ValidateCommand) Run() error {
+	docsRoot, err := resolveDocsRoot(c.Output)
+	if err != nil {
+		return err
+	}
+	schemaPath := c.Schema
+	if schemaPath == "" {
+		schemaPath = filepath.Join(docsRoot, filepath.FromSlash(frontmatterSchemaPath))
+	}
+	schema, err := loadFrontmatterSchema(schemaPath)
+	if err != nil {
+		return err
+	}
+	site, err := loadDocsSite(docsRoot, nil)
+	if err != nil {
+		return err
+	}
+	c.logger.Info().Any("schema", schemaPath).Any("pages", len(site.Files)).Msg("Validating docs frontmatter")
+
+	report, err := validateFrontmatter(docsRoot, site.Files, schema)
+	if err != nil {
+		return err
+	}
+	for _, problem := range report.Problems {
+		fmt.Println(problem)
+	}
+	if len(report.Problems) > 0 {
+		fmt.Println()
+	}
+	fmt.Print(report.summary())
+	if len(report.Problems) > 0 {
+		return fmt.Errorf("frontmatter is invalid in %d place(s)", len(report.Problems))
+	}
+	c.logger.Info().Msg("Docs frontmatter matches the schema")
+	return nil
+}
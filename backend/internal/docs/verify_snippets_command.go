@@ -0,0 +1,106 @@
+package docs
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/goforj/docs/internal/logger"
+)
+
+// VerifySnippetsCommand type-checks the Go fences in synced library READMEs.
+type VerifySnippetsCommand struct {
+	Repo     string `name:"repo" help:"Only verify snippets for a single repo slug (e.g. cache, queue, str)"`
+	Source   string `name:"source" type:"path" help:"Use a local repo checkout as the source (requires --repo)"`
+	CacheDir string `name:"cache-dir" type:"path" env:"DOCS_CACHE_DIR" help:"Directory holding the checkouts synced by docs:generate"`
+	logger   *logger.AppLogger
+}
+
+// NewDocsVerifySnippetsCommand creates a new VerifySnippetsCommand.
+func NewDocsVerifySnippetsCommand(logger *logger.AppLogger) *VerifySnippetsCommand {
+	return &VerifySnippetsCommand{
+		logger: logger,
+	}
+}
+
+// Run reports every failing snippet before returning so one broken README does not hide the rest.
+func (c *VerifySnippetsCommand) Run() error {
+	localSource, err := resolveLocalSource(c.Repo, c.Source)
+	if err != nil {
+		return err
+	}
+	repos, err := selectRepos(defaultRepos(), c.Repo)
+	if err != nil {
+		return err
+	}
+	cacheRoot, err := resolveCacheRoot(c.CacheDir)
+	if err != nil {
+		return err
+	}
+	c.logger.Info().Any("cache", cacheRoot).Msg("Resolved snippet verification paths")
+
+	checkouts, err := resolveCheckouts(repos, cacheRoot, localSource, true)
+	if err != nil {
+		return err
+	}
+
+	failures := 0
+	for _, checkout := range checkouts {
+		checked, failed, err := verifyCheckoutSnippets(os.Stdout, checkout)
+		if err != nil {
+			return err
+		}
+		failures += failed
+		c.logger.Info().
+			Any("repo", checkout.Repo.Slug).
+			Any("snippets", checked).
+			Any("failed", failed).
+			Msg("Verified README snippets")
+	}
+	if failures > 0 {
+		return fmt.Errorf("%d README snippet(s) failed verification", failures)
+	}
+	return nil
+}
+
+// verifyCheckoutSnippets prints one editor-friendly line per problem and returns checked and failed snippet counts.
+func verifyCheckoutSnippets(out io.Writer, checkout repoCheckout) (int, int, error) {
+	readmeRelativePath := checkout.Repo.ReadmePath
+	if readmeRelativePath == "" {
+		readmeRelativePath = "README.md"
+	}
+	readmePath := filepath.Join(checkout.Dir, filepath.FromSlash(readmeRelativePath))
+	readme, err := os.ReadFile(readmePath)
+	if err != nil {
+		return 0, 0, fmt.Errorf("read README %s for %s: %w", readmeRelativePath, checkout.Repo.Slug, err)
+	}
+
+	checker := newSnippetChecker(checkout.Dir)
+	checked := 0
+	failed := 0
+	for _, snippet := range extractGoSnippets(string(readme)) {
+		if snippet.Skip {
+			continue
+		}
+		checked++
+		sourcePath := filepath.Join(filepath.Dir(readmePath), fmt.Sprintf("readme_snippet_%d.go", snippet.Line))
+		problems := checker.check(snippet, readmePath, sourcePath)
+		if len(problems) == 0 {
+			continue
+		}
+		failed++
+		for _, problem := range problems {
+			line := problem.Line
+			if line == 0 {
+				line = snippet.Line
+			}
+			location := fmt.Sprintf("%s/%s:%d", checkout.Repo.Slug, readmeRelativePath, line)
+			if problem.Column > 0 {
+				location = fmt.Sprintf("%s:%d", location, problem.Column)
+			}
+			fmt.Fprintf(out, "%s: %s\n", location, problem.Message)
+		}
+	}
+	return checked, failed, nil
+}
@@ -0,0 +1,57 @@
+package http
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+)
+
+// registerBundle replaces the embedded site with an exported archive whose entries sit at the archive root.
+func registerBundle(bundle fs.FS) error {
+	if _, err := fs.Stat(bundle, "index.html"); err != nil {
+		return fmt.Errorf("no index.html at the archive root")
+	}
+	RegisterSpa("/*", ".", bundleFS{bundle})
+	return nil
+}
+
+// bundleFS buffers archive entries on open, because the static middleware seeks to sniff content types and zip
+// entries only stream forward.
+type bundleFS struct {
+	fs.FS
+}
+
+// Open reads a file entry into memory; directories are passed through unchanged.
+func (b bundleFS) Open(name string) (fs.File, error) {
+	file, err := b.FS.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil || info.IsDir() {
+		return file, err
+	}
+	defer file.Close()
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	return &bundleFile{Reader: bytes.NewReader(content), info: info}, nil
+}
+
+// bundleFile is a buffered archive entry.
+type bundleFile struct {
+	*bytes.Reader
+	info fs.FileInfo
+}
+
+// Stat returns the archive entry's file info.
+func (f *bundleFile) Stat() (fs.FileInfo, error) {
+	return f.info, nil
+}
+
+// Close is a no-op; the entry was read when it was opened.
+func (f *bundleFile) Close() error {
+	return nil
+}
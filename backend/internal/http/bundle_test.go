@@ -0,0 +1,80 @@
+package http
+
+import (
+	"archive/zip"
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/goforj/docs/internal/logger"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterBundleServesExportedSite(t *testing.T) {
+	original := spas
+	t.Cleanup(func() { spas = original })
+	spas = []Spa{{baseUri: "/*", root: "frontend/dist"}}
+
+	bundle := testBundle(t, map[string]string{
+		"index.html":       "home",
+		"guide/setup.html": "setup",
+		"core/index.html":  "core",
+		"assets/app.js":    "app",
+	})
+	if !assert.NoError(t, registerBundle(bundle)) {
+		return
+	}
+	assert.Len(t, GetSpas(), 1)
+
+	s := &Server{logger: logger.NewSilentLogger()}
+	e := echo.New()
+	s.registerSinglePageApplications(e)
+
+	for requestPath, want := range map[string]string{
+		"/":              "home",
+		"/guide/setup":   "setup",
+		"/core/":         "core",
+		"/assets/app.js": "app",
+	} {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, requestPath, nil))
+		assert.Equal(t, http.StatusOK, rec.Code, requestPath)
+		assert.Equal(t, want, rec.Body.String(), requestPath)
+	}
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/assets/missing.js", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestRegisterBundleRequiresRootIndex(t *testing.T) {
+	bundle := testBundle(t, map[string]string{"site/index.html": "home"})
+	assert.Error(t, registerBundle(bundle))
+}
+
+// Helpers
+
+func testBundle(t *testing.T, files map[string]string) *zip.Reader {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return reader
+}
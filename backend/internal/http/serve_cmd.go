@@ -1,6 +1,9 @@
 package http
 
 import (
+	"archive/zip"
+	"fmt"
+
 	"github.com/goforj/docs/internal/logger"
 )
 
@@ -10,7 +13,8 @@ type ServeCmd struct {
 	server *Server
 
 	// flags / args
-	Port string `help:"Port to listen on" default:"3000" optional:""`
+	Port   string `help:"Port to listen on" default:"3000" optional:""`
+	Bundle string `help:"Serve the site from a docs:export zip instead of the embedded build" type:"existingfile" optional:""`
 }
 
 // NewServeCmd creates a new instance of ServeCmd.
@@ -26,6 +30,18 @@ func (c *ServeCmd) Run() error {
 	c.logger.Info().Msg("Hello from http:serve command")
 	c.logger.Info().Msg("Dependency injection works!")
 
+	if c.Bundle != "" {
+		bundle, err := zip.OpenReader(c.Bundle)
+		if err != nil {
+			return fmt.Errorf("open bundle: %w", err)
+		}
+		defer bundle.Close()
+		if err := registerBundle(bundle); err != nil {
+			return fmt.Errorf("bundle %s: %w", c.Bundle, err)
+		}
+		c.logger.Info().Str("bundle", c.Bundle).Msg("Serving exported site bundle")
+	}
+
 	err := c.server.Serve(c.Port)
 	if err != nil {
 		return err
@@ -1,12 +1,12 @@
 package http
 
-import "embed"
+import "io/fs"
 
 // Spa represents a single SPA (Single Page Application) with its root and file system.
 type Spa struct {
 	root    string
 	baseUri string
-	fs      *embed.FS
+	fs      fs.FS
 }
 
 // BaseUri returns the base URI of the SPA.
@@ -19,16 +19,23 @@ func (s *Spa) FileRoot() string {
 	return s.root
 }
 
-// Filesystem returns the embedded file system of the SPA.
-func (s *Spa) Filesystem() *embed.FS {
+// Filesystem returns the file system the SPA is served from.
+func (s *Spa) Filesystem() fs.FS {
 	return s.fs
 }
 
 // spas is a slice of registered SPAs.
 var spas = make([]Spa, 0)
 
-// RegisterSpa registers a new SPA with the given root and file system.
-func RegisterSpa(baseUri string, root string, fs *embed.FS) {
+// RegisterSpa registers a new SPA with the given root and file system. Registering a base URI again replaces the
+// earlier SPA, which lets http:serve --bundle swap the embedded site for an exported archive.
+func RegisterSpa(baseUri string, root string, fs fs.FS) {
+	for i, spa := range spas {
+		if spa.baseUri == baseUri {
+			spas[i] = Spa{root: root, baseUri: baseUri, fs: fs}
+			return
+		}
+	}
 	spas = append(spas, Spa{
 		root:    root,
 		baseUri: baseUri,
@@ -22,7 +22,21 @@ func InitializeApplication() (App, error) {
 	appLogger := logger.ProvideAppLogger()
 	helloWorldCmd := cmd.NewHelloWorldCmd(appLogger)
 	generateCommand := docs.NewDocsGenerateCommand(appLogger)
-	appCommands := cmd.NewAppCommands(helloWorldCmd, generateCommand)
+	apiCommand := docs.NewDocsAPICommand(appLogger)
+	verifySnippetsCommand := docs.NewDocsVerifySnippetsCommand(appLogger)
+	changelogCommand := docs.NewDocsChangelogCommand(appLogger)
+	driversCommand := docs.NewDocsDriversCommand(appLogger)
+	linksCommand := docs.NewDocsLinksCommand(appLogger)
+	lintCommand := docs.NewDocsLintCommand(appLogger)
+	termsCommand := docs.NewDocsTermsCommand(appLogger)
+	validateCommand := docs.NewDocsValidateCommand(appLogger)
+	releaseCommand := docs.NewDocsReleaseCommand(appLogger)
+	snapshotCommand := docs.NewDocsSnapshotCommand(appLogger)
+	redirectsCommand := docs.NewDocsRedirectsCommand(appLogger)
+	scenariosCommand := docs.NewDocsScenariosCommand(appLogger)
+	exportCommand := docs.NewDocsExportCommand(appLogger)
+	historyCommand := docs.NewDocsHistoryCommand(appLogger)
+	appCommands := cmd.NewAppCommands(helloWorldCmd, generateCommand, apiCommand, verifySnippetsCommand, changelogCommand, driversCommand, linksCommand, lintCommand, termsCommand, validateCommand, releaseCommand, snapshotCommand, redirectsCommand, scenariosCommand, exportCommand, historyCommand)
 	helloController := hello.NewController(appLogger)
 	appRoutes := router.ProvideAppRoutes(helloController)
 	v := router.ProvideRoutes(appRoutes)
@@ -12,12 +12,21 @@
 //   library publishes in its README (img.shields.io/badge/unit_tests-N,
 //   integration_tests-N). These are executed test cases, including
 //   driver-matrix subtests, and are what each repo publicly claims.
-// - "test functions" / "benchmarks" = mechanical counts of
-//   `func Test...` / `func Benchmark...` declarations in *_test.go files,
-//   reported per repo as a secondary, independently checkable number.
+// - "test functions" / "benchmarks" = mechanical counts of the top-level
+//   `func Test...` / `func Benchmark...` declarations go test would run in
+//   *_test.go files outside testdata (not TestMain or helpers such as
+//   Testify), reported per repo as a secondary, independently checkable
+//   number.
 // - Driver counts are the published driver matrices of each primitive
 //   (see /drivers), kept in this file so the landing page and the matrix
-//   cannot drift apart.
+//   cannot drift apart. `docs:drivers` writes the same drivers block from
+//   the driver packages in code and `docs:drivers --check` verifies it, so
+//   the README matrices read here must agree with the code.
+//
+// `docs:generate` refreshes the per-repo entries and totals for the libraries
+// it renders, counting the same declarations from the Go syntax tree, so pages
+// and proof numbers share one source revision. Keep the two in step when
+// changing the methodology.
 
 import fs from 'node:fs'
 import path from 'node:path'
@@ -130,7 +139,7 @@ const DRIVERS = {
 
 const walkGoTestFiles = (dir, files = []) => {
   for (const entry of fs.readdirSync(dir, { withFileTypes: true })) {
-    if (entry.name === 'node_modules' || entry.name === 'vendor' || entry.name.startsWith('.')) continue
+    if (entry.name === 'node_modules' || entry.name === 'vendor' || entry.name === 'testdata' || entry.name.startsWith('.')) continue
     const full = path.join(dir, entry.name)
     if (entry.isDirectory()) walkGoTestFiles(full, files)
     else if (entry.name.endsWith('_test.go')) files.push(full)
@@ -166,8 +175,8 @@ for (const lib of LIBS) {
   let benchmarks = 0
   for (const file of walkGoTestFiles(dir)) {
     const content = fs.readFileSync(file, 'utf-8')
-    testFns += count(content, /^func Test/gm)
-    benchmarks += count(content, /^func Benchmark/gm)
+    testFns += count(content, /^func Test(?!Main\s*\()(?![a-z])\w*\s*\(/gm)
+    benchmarks += count(content, /^func Benchmark(?![a-z])\w*\s*\(/gm)
   }
   const readmePath = path.join(dir, 'README.md')
   const readme = fs.existsSync(readmePath) ? fs.readFileSync(readmePath, 'utf-8') : ''
@@ -1,7 +1,9 @@
 import { defineConfig } from 'vitepress'
 import fs from 'node:fs'
 import path from 'node:path'
+import redirects from './data/redirects.json'
 import release from './data/release.json'
+import versions from '../public/versions.json'
 
 const lucideIconKeys = [
   'activity',
@@ -196,6 +198,11 @@ const isProd = process.env.NODE_ENV === 'production'
 const siteUrl = (process.env.SITE_URL || 'https://goforj.dev').replace(/\/+$/, '')
 const siteDescription = 'The composable stack for building with Go. Build Go applications with one cohesive application model, explicit wiring, local-first drivers, and production-ready primitives.'
 const docsVersion = 'Unreleased'
+// Written by `make docs-history` while .git is available. Docker build stages copy the tree without .git, so
+// lastUpdated comes from this manifest instead of VitePress shelling out to git; without it pages simply omit the date.
+const pageHistoryFile = new URL('./data/page-history.json', import.meta.url)
+const pageHistory: Record<string, { lastUpdated: string; commit: string; repo?: string; authors: string[] }> =
+  fs.existsSync(pageHistoryFile) ? JSON.parse(fs.readFileSync(pageHistoryFile, 'utf8')).pages : {}
 const faviconVersion = '20260731-2'
 const socialImage = process.env.SOCIAL_IMAGE_URL || `${siteUrl}/assets/goforj-og-20260731.png`
 const socialIcon = process.env.SOCIAL_ICON_URL || `${siteUrl}/apple-touch-icon.png?v=${faviconVersion}`
@@ -222,6 +229,16 @@ const pageUrl = (page: string) => {
   return `${siteUrl}${cleanPath ? `/${cleanPath}` : '/'}`
 }
 
+// Generated library pages list their language versions in frontmatter (docs:generate); English doubles as x-default.
+const hreflangAlternates = (entries: unknown) => {
+  if (!Array.isArray(entries)) return []
+  const valid = entries.filter((entry) => typeof entry?.lang === 'string' && typeof entry?.link === 'string')
+  const links = valid.map((entry) => ['link', { rel: 'alternate', hreflang: entry.lang, href: `${siteUrl}${entry.link}` }])
+  const english = valid.find((entry) => entry.lang === 'en')
+  if (english) links.push(['link', { rel: 'alternate', hreflang: 'x-default', href: `${siteUrl}${english.link}` }])
+  return links
+}
+
 const absoluteUrl = (value: string, page: string) => {
   if (!value) return ''
   if (/^(https?:)?\/\//i.test(value)) {
@@ -562,154 +579,8 @@ type ConsolidatedPageRedirect = {
   fragments: Record<string, string>
 }
 
-const consolidatedPageRedirects: Record<string, ConsolidatedPageRedirect> = {
-  'applications/openapi': {
-    to: '/applications/api-index',
-    fragments: {
-      'generate-the-document': 'generate-the-contract',
-      'api-reference-routes': 'serve-openapi',
-      configuration: 'serve-openapi',
-      'improve-generated-metadata': 'what-goforj-infers',
-      'ci-policy': 'diagnostics-and-strict-ci',
-      'current-limits': 'current-limits',
-      'common-mistakes': 'common-mistakes',
-      'next-steps': 'next-steps'
-    }
-  },
-  'core/app': {
-    to: '/core/apps',
-    fragments: {
-      'default-app': 'the-default-app',
-      'named-apps': 'add-another-app',
-      'app-versus-runtime': 'apps-and-runtimes',
-      'app-versus-project': 'what-belongs-where',
-      'extension-points': 'what-belongs-where',
-      'common-mistakes': 'common-mistakes',
-      'next-steps': 'next-steps'
-    }
-  },
-  'core/generated-components': {
-    to: '/core/code-generation',
-    fragments: {
-      'why-they-exist': 'choose-the-project-shape',
-      'project-rendering': 'choose-the-project-shape',
-      'build-time-generation': 'build-and-refresh-generated-code',
-      'focused-generation': 'build-and-refresh-generated-code',
-      'generated-managers': 'use-generated-resources',
-      'named-resources': 'use-generated-resources',
-      'driver-support': 'compile-driver-support',
-      'render-once-files': 'choose-a-safe-extension-point',
-      'when-to-regenerate': 'inputs-that-require-a-rebuild',
-      'common-mistakes': 'common-mistakes',
-      'next-steps': 'next-steps'
-    }
-  },
-  'core/generated-extension-points': {
-    to: '/core/code-generation',
-    fragments: {
-      'ownership-model': 'choose-a-safe-extension-point',
-      'lifecycle-hooks': 'choose-a-safe-extension-point',
-      routes: 'choose-a-safe-extension-point',
-      commands: 'choose-a-safe-extension-point',
-      schedules: 'choose-a-safe-extension-point',
-      'jobs-and-events': 'choose-a-safe-extension-point',
-      'lighthouse-and-operator-glue': 'choose-a-safe-extension-point',
-      'when-to-change-the-framework': 'decide-whether-to-change-the-app-or-framework',
-      'common-mistakes': 'common-mistakes',
-      'next-steps': 'next-steps'
-    }
-  },
-  'core/organizing-generated-code': {
-    to: '/reference/make-commands',
-    fragments: {
-      'what-package-scope-means-in-go': 'organize-by-package-ownership',
-      'the-mental-model': 'organize-by-package-ownership',
-      'why-this-shape': 'organize-by-package-ownership',
-      'names-become-packages': 'organize-by-package-ownership',
-      'a-complete-package-example': 'organize-by-package-ownership',
-      'commands-are-slightly-different': 'package-placement',
-      'bare-commands-stay-in-internal-cmd': 'package-placement',
-      'package-names-are-go-names': 'package-placement',
-      'what-each-make-command-adds': 'command-map',
-      'removing-generated-package-entries': 'removing-generated-resources',
-      'a-good-package-shape': 'organize-by-package-ownership',
-      'common-mistakes': 'ownership-and-verification',
-      'next-steps': 'next-steps'
-    }
-  },
-  'core/providers': {
-    to: '/core/dependency-injection',
-    fragments: {
-      shape: 'providers',
-      'what-providers-build': 'providers',
-      'what-they-should-not-do': 'provider-boundaries',
-      'required-dependencies': 'providers',
-      'golden-path': 'providers',
-      'when-a-dedicated-provider-helps': 'when-a-dedicated-provider-helps',
-      boundaries: 'provider-boundaries',
-      verify: 'providers',
-      'next-steps': 'next-steps'
-    }
-  },
-  'data/database-shell': {
-    to: '/data/database-strategy',
-    fragments: {
-      'open-a-connection': 'open-the-default-connection',
-      'named-connections': 'named-connections',
-      'launch-method': 'shell-options',
-      'non-interactive-sql': 'shell-options',
-      'client-arguments': 'shell-options',
-      notes: 'shell-options'
-    }
-  },
-  'developer-tools/editor-open': {
-    to: '/reference/make-commands',
-    fragments: {
-      'supported-commands': 'opening-generated-files',
-      'automatic-opening': 'opening-generated-files',
-      'editor-resolution': 'opening-generated-files',
-      'related-pages': 'shared-options'
-    }
-  },
-  'operations/production-checklist': {
-    to: '/operations/deployment-basics',
-    fragments: {
-      'build-and-configuration': 'production-checklist',
-      'runtime-topology': 'production-checklist',
-      data: 'production-checklist',
-      observability: 'production-checklist',
-      async: 'production-checklist',
-      'final-check': 'production-checklist',
-      'next-steps': 'next-steps'
-    }
-  },
-  'operations/standalone-vs-distributed': {
-    to: '/operations/runtime-processes',
-    fragments: {
-      standalone: 'choose-the-processes-to-supervise',
-      distributed: 'choose-the-processes-to-supervise',
-      'choosing-the-topology': 'choose-the-processes-to-supervise',
-      'common-mistakes': 'choose-the-processes-to-supervise',
-      'next-steps': 'next-steps'
-    }
-  },
-  'testing/overview': {
-    to: '/testing/',
-    fragments: {
-      'testing-layers': 'choose-a-test-layer',
-      'local-test-command': 'choose-a-test-layer',
-      'test-services-directly': 'keep-domain-behavior-direct',
-      'test-http-behavior': 'choose-a-test-layer',
-      'test-events': 'keep-domain-behavior-direct',
-      'test-queues-and-jobs': 'keep-domain-behavior-direct',
-      'test-scheduler-work': 'keep-domain-behavior-direct',
-      'rendered-app-smoke-tests': 'maintainer-workflows',
-      'integration-tests': 'maintainer-workflows',
-      'common-mistakes': 'choose-a-test-layer',
-      'next-steps': 'related-sections'
-    }
-  }
-}
+// Redirects live in data/redirects.json so docs:redirects --check can verify them without a build.
+const consolidatedPageRedirects: Record<string, ConsolidatedPageRedirect> = redirects
 
 // generateConsolidatedPageRedirects preserves published URLs after overlapping guides move to one canonical page.
 function generateConsolidatedPageRedirects(outDir: string) {
@@ -1038,10 +909,16 @@ const referenceSidebar = sectionSidebar('Reference', [
   { text: 'Errors', link: '/reference/errors' }
 ])
 
+// Written by `make docs-changelog` from the checkouts docs:generate synced; trees that never ran it have no index to link.
+const libraryChangesIndex = new URL('../versions/libraries/index.md', import.meta.url)
+
 const versionsSidebar = sectionSidebar('Versions', [
   { text: 'Active development', link: '/versions/' },
   { text: `Latest tag ${release.latest}`, link: `/versions/changelog#${releaseAnchor}` },
-  { text: 'Changelog', link: '/versions/changelog' }
+  { text: 'Changelog', link: '/versions/changelog' },
+  ...(fs.existsSync(libraryChangesIndex) ? [{ text: 'Library changes', link: '/versions/libraries/' }] : []),
+  // Frozen lines written by `make docs-snapshot`, newest first.
+  ...versions.snapshots.map((snapshot) => ({ text: `${snapshot.version} docs`, link: snapshot.path }))
 ])
 
 const aboutSidebar = sectionSidebar('About', [
@@ -1232,8 +1109,13 @@ export default defineConfig({
     ...analyticsHead
   ],
 
+  transformPageData(pageData) {
+    const history = pageHistory[pageData.filePath]
+    if (history) pageData.lastUpdated = Date.parse(history.lastUpdated)
+  },
+
   transformHead(context) {
-    const { page, title } = context
+    const { page, title, pageData } = context
     const socialTitle = title || 'GoForj'
     const socialMeta = resolvePageSocialMetadata(context)
     const socialDescription = socialMeta.description
@@ -1257,6 +1139,7 @@ export default defineConfig({
 
     return [
       ['link', { rel: 'canonical', href: pageUrl(page) }],
+      ...hreflangAlternates(pageData.frontmatter?.hreflang),
       ...(preloadImage ? [['link', { rel: 'preload', as: 'image', href: preloadImage, fetchpriority: 'high' }]] : []),
       ['meta', { property: 'og:type', content: 'website' }],
       ['meta', { property: 'og:site_name', content: 'GoForj' }],